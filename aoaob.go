@@ -0,0 +1,184 @@
+package maybe
+
+import "fmt"
+
+// AoAoB implements the Maybe monad for a 2-D slice of bools.  An AoAoB is
+// considered 'valid' or 'invalid' depending on whether it contains a 2-D
+// slice of bools or an error value.  A zero-value AoAoB is invalid and
+// Unbox() will return an error to that effect.
+type AoAoB Grid[bool]
+
+// NewAoAoB constructs an AoAoB from a given 2-D slice of bools or error.
+// If e is not nil, returns ErrAoAoB(e), otherwise returns JustAoAoB(x).
+func NewAoAoB(x [][]bool, e error) AoAoB {
+	if e != nil {
+		return ErrAoAoB(e)
+	}
+	return JustAoAoB(x)
+}
+
+// JustAoAoB constructs a valid AoAoB from a given 2-D slice of bools.
+func JustAoAoB(x [][]bool) AoAoB {
+	return AoAoB{just: x}
+}
+
+// ErrAoAoB constructs an invalid AoAoB from a given error.
+func ErrAoAoB(e error) AoAoB {
+	return AoAoB{err: e}
+}
+
+// NothingAoAoB constructs an invalid AoAoB that holds nothing, for a missing
+// rather than a failed value.  See ErrNothing.
+func NothingAoAoB() AoAoB {
+	return AoAoB{err: ErrNothing}
+}
+
+// IsErr returns true for an invalid AoAoB.
+func (m AoAoB) IsErr() bool {
+	return m.just == nil || m.err != nil
+}
+
+// Bind applies a function that takes a 2-D slice of bools and returns an
+// AoAoB.
+func (m AoAoB) Bind(f func(x [][]bool) AoAoB) AoAoB {
+	if m.IsErr() {
+		return m
+	}
+
+	return try(f, m.just, ErrAoAoB)
+}
+
+// Join applies a function to each row of a valid AoAoB and returns an AoB
+// of the results.  If the AoAoB is invalid or if any function returns an
+// invalid B, Join returns an invalid AoB.
+func (m AoAoB) Join(f func(x []bool) B) AoB {
+	return AoB(JoinGrid(Grid[bool](m).checkZero("Join", "AoAoB"), toMaybe(f)))
+}
+
+// Flatten joins a 2-D slice of bools into a 1-D slice
+func (m AoAoB) Flatten() AoB {
+	return AoB(Grid[bool](m).checkZero("Flatten", "AoAoB").Flatten())
+}
+
+// Map applies a function to each element of a valid AoAoB (i.e. a 1-D slice)
+// and returns a new AoAoB.  If the AoAoB is invalid or if any function
+// returns an invalid AoB, Map returns an invalid AoAoB.
+func (m AoAoB) Map(f func(x []bool) AoB) AoAoB {
+	return AoAoB(MapGrid(Grid[bool](m).checkZero("Map", "AoAoB"), toSlice(f)))
+}
+
+// Filter returns an AoAoB of the rows of a valid AoAoB for which a function
+// returns true, in order.  If the AoAoB is invalid or if any function returns
+// an invalid B, Filter returns an invalid AoAoB.
+func (m AoAoB) Filter(f func(x []bool) B) AoAoB {
+	return AoAoB(Grid[bool](m).checkZero("Filter", "AoAoB").Filter(toMaybe(f)))
+}
+
+// Reject is the opposite of Filter: it returns an AoAoB of the rows of a valid
+// AoAoB for which a function returns false.
+func (m AoAoB) Reject(f func(x []bool) B) AoAoB {
+	return AoAoB(Grid[bool](m).checkZero("Reject", "AoAoB").Reject(toMaybe(f)))
+}
+
+// Partition splits a valid AoAoB into the rows for which a function returns
+// true and those for which it returns false.  If the AoAoB is invalid or if any
+// function returns an invalid B, both results are invalid.
+func (m AoAoB) Partition(f func(x []bool) B) (AoAoB, AoAoB) {
+	in, out := Grid[bool](m).checkZero("Partition", "AoAoB").Partition(toMaybe(f))
+	return AoAoB(in), AoAoB(out)
+}
+
+// All returns an AoB with, for each row of a valid AoAoB, whether every
+// element of the row is true.  If the AoAoB is invalid, All returns an
+// invalid AoB.
+func (m AoAoB) All() AoB {
+	return m.Join(func(xs []bool) B { return JustAoB(xs).All() })
+}
+
+// Any returns an AoB with, for each row of a valid AoAoB, whether at least
+// one element of the row is true.  If the AoAoB is invalid, Any returns an
+// invalid AoB.
+func (m AoAoB) Any() AoB {
+	return m.Join(func(xs []bool) B { return JustAoB(xs).Any() })
+}
+
+// Count returns an AoI with the number of true elements in each row of a
+// valid AoAoB.  If the AoAoB is invalid, Count returns an invalid AoI.
+func (m AoAoB) Count() AoI {
+	return AoI(JoinGrid(Grid[bool](m).checkZero("Count", "AoAoB"), func(xs []bool) Maybe[int] {
+		return Maybe[int](JustAoB(xs).Count())
+	}))
+}
+
+// IsNothing returns true for an AoAoB that holds nothing, i.e. one whose error
+// is ErrNothing itself.  Such an AoAoB is also invalid, so IsErr returns true.
+// An error that only wraps ErrNothing, such as an ElementError for a missing
+// element, is an ordinary error.
+func (m AoAoB) IsNothing() bool {
+	return Grid[bool](m).IsNothing()
+}
+
+// JustOr returns a valid AoAoB holding a default value if the AoAoB holds
+// nothing, or otherwise returns the AoAoB unchanged.  Unlike OrElse, it leaves
+// other errors in place.  A nil default is replaced by an empty one, as for
+// OrElse.
+func (m AoAoB) JustOr(x [][]bool) AoAoB {
+	return AoAoB(Grid[bool](m).JustOr(x))
+}
+
+// OrElse returns the AoAoB if it is valid, or otherwise a valid AoAoB holding a
+// default value.  A nil default is replaced by an empty one, so the result is
+// always valid.
+func (m AoAoB) OrElse(x [][]bool) AoAoB {
+	return AoAoB(Grid[bool](m).OrElse(x))
+}
+
+// Recover returns the AoAoB if it is valid, or otherwise a valid AoAoB holding
+// the value returned by a function that takes the error, with nil replaced by
+// an empty value as for OrElse.
+func (m AoAoB) Recover(f func(err error) [][]bool) AoAoB {
+	return AoAoB(Grid[bool](m).checkZero("Unbox", "AoAoB").Recover(f))
+}
+
+// MapErr returns the AoAoB if it is valid, or otherwise an invalid AoAoB with
+// the error returned by a function that takes the original error, e.g. to add
+// context with fmt.Errorf and %w.  If the function returns nil, the original
+// error is kept.
+func (m AoAoB) MapErr(f func(err error) error) AoAoB {
+	return AoAoB(Grid[bool](m).checkZero("Unbox", "AoAoB").MapErr(f))
+}
+
+// Catch is like Recover, but only for errors that match a target according
+// to errors.Is, and the function returns an AoAoB, so it may fail again.  Valid
+// values and other errors are returned unchanged.
+func (m AoAoB) Catch(target error, f func(err error) AoAoB) AoAoB {
+	return AoAoB(Grid[bool](m).checkZero("Unbox", "AoAoB").Catch(target, toGrid(f)))
+}
+
+// MarshalJSON implements json.Marshaler using the wire format described in
+// the package documentation.
+func (m AoAoB) MarshalJSON() ([]byte, error) {
+	return marshalJSON(m.Unbox())
+}
+
+// UnmarshalJSON implements json.Unmarshaler using the wire format described
+// in the package documentation.
+func (m *AoAoB) UnmarshalJSON(b []byte) error {
+	return unmarshalJSON(b, (*Maybe[[][]bool])(m), "AoAoB")
+}
+
+// String returns a string representation, mostly useful for debugging.
+func (m AoAoB) String() string {
+	if m.IsErr() {
+		return fmt.Sprintf("Err %v", m.err)
+	}
+	return fmt.Sprintf("Just %v", m.just)
+}
+
+// Unbox returns the underlying 2-D slice of bools or error.
+func (m AoAoB) Unbox() ([][]bool, error) {
+	if m.just == nil && m.err == nil {
+		return nil, &OpError{Op: "Unbox", Type: "AoAoB", Err: ErrZeroValue}
+	}
+	return m.just, m.err
+}
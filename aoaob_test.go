@@ -0,0 +1,53 @@
+package maybe_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/xdg/maybe"
+	"github.com/xdg/testy"
+)
+
+func TestAoAoB(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	input := [][]bool{{true, false}, {true}}
+	good := maybe.JustAoAoB(input)
+	bad := maybe.ErrAoAoB(errors.New("bad bools"))
+
+	just, err := good.Unbox()
+	is.Equal(just, input)
+	is.Nil(err)
+	is.False(good.IsErr())
+	is.True(bad.IsErr())
+	is.True(maybe.AoAoB{}.IsErr())
+	is.Equal(maybe.NewAoAoB(input, nil), good)
+	is.Equal(good.String(), "Just [[true false] [true]]")
+
+	flat, err := good.Flatten().Unbox()
+	is.Equal(flat, []bool{true, false, true})
+	is.Nil(err)
+
+	// Row-wise aggregates
+	is.Equal(good.All(), maybe.JustAoB([]bool{false, true}))
+	is.Equal(good.Any(), maybe.JustAoB([]bool{true, true}))
+	is.Equal(good.Count(), maybe.JustAoI([]int{1, 1}))
+	is.True(bad.All().IsErr())
+	is.True(bad.Any().IsErr())
+	is.True(bad.Count().IsErr())
+}
+
+func TestAoAoBFromPredicate(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	nonEmpty := func(s string) maybe.B { return maybe.JustB(s != "") }
+	rows := maybe.JustAoAoS([][]string{{"a", "b"}, {"c", ""}})
+
+	// Which rows have every field filled in?
+	is.Equal(rows.ToBool(nonEmpty).All(), maybe.JustAoB([]bool{true, false}))
+
+	positive := func(x int) maybe.B { return maybe.JustB(x > 0) }
+	is.Equal(maybe.JustAoAoI([][]int{{1, -1}}).ToBool(positive), maybe.JustAoAoB([][]bool{{true, false}}))
+}
@@ -0,0 +1,166 @@
+package maybe
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// AoAoBigI implements the Maybe monad for a 2-D slice of *big.Ints.  An
+// AoAoBigI is considered 'valid' or 'invalid' depending on whether it contains
+// a 2-D slice of *big.Ints or an error value.  A zero-value AoAoBigI is invalid
+// and Unbox() will return an error to that effect.
+type AoAoBigI Grid[*big.Int]
+
+// NewAoAoBigI constructs an AoAoBigI from a given 2-D slice of *big.Ints or
+// error. If e is not nil, returns ErrAoAoBigI(e), otherwise returns
+// JustAoAoBigI(x).
+func NewAoAoBigI(x [][]*big.Int, e error) AoAoBigI {
+	if e != nil {
+		return ErrAoAoBigI(e)
+	}
+	return JustAoAoBigI(x)
+}
+
+// JustAoAoBigI constructs a valid AoAoBigI from a given 2-D slice of *big.Ints.
+func JustAoAoBigI(x [][]*big.Int) AoAoBigI {
+	return AoAoBigI{just: x}
+}
+
+// ErrAoAoBigI constructs an invalid AoAoBigI from a given error.
+func ErrAoAoBigI(e error) AoAoBigI {
+	return AoAoBigI{err: e}
+}
+
+// NothingAoAoBigI constructs an invalid AoAoBigI that holds nothing, for a
+// missing rather than a failed value.  See ErrNothing.
+func NothingAoAoBigI() AoAoBigI {
+	return AoAoBigI{err: ErrNothing}
+}
+
+// IsErr returns true for an invalid AoAoBigI.
+func (m AoAoBigI) IsErr() bool {
+	return m.just == nil || m.err != nil
+}
+
+// Bind applies a function that takes a 2-D slice of *big.Ints and returns an
+// AoAoBigI.
+func (m AoAoBigI) Bind(f func(x [][]*big.Int) AoAoBigI) AoAoBigI {
+	if m.IsErr() {
+		return m
+	}
+
+	return try(f, m.just, ErrAoAoBigI)
+}
+
+// Join applies a function to each row of a valid AoAoBigI and returns an AoBigI
+// of the results.  If the AoAoBigI is invalid or if any function returns an
+// invalid BigI, Join returns an invalid AoBigI.
+func (m AoAoBigI) Join(f func(x []*big.Int) BigI) AoBigI {
+	return AoBigI(JoinGrid(Grid[*big.Int](m).checkZero("Join", "AoAoBigI"), toMaybe(f)))
+}
+
+// Flatten joins a 2-D slice of *big.Ints into a 1-D slice
+func (m AoAoBigI) Flatten() AoBigI {
+	return AoBigI(Grid[*big.Int](m).checkZero("Flatten", "AoAoBigI").Flatten())
+}
+
+// Map applies a function to each element of a valid AoAoBigI (i.e. a 1-D slice)
+// and returns a new AoAoBigI.  If the AoAoBigI is invalid or if any function
+// returns an invalid AoBigI, Map returns an invalid AoAoBigI.
+func (m AoAoBigI) Map(f func(x []*big.Int) AoBigI) AoAoBigI {
+	return AoAoBigI(MapGrid(Grid[*big.Int](m).checkZero("Map", "AoAoBigI"), toSlice(f)))
+}
+
+// Filter returns an AoAoBigI of the rows of a valid AoAoBigI for which a
+// function returns true, in order.  If the AoAoBigI is invalid or if any
+// function returns an invalid B, Filter returns an invalid AoAoBigI.
+func (m AoAoBigI) Filter(f func(x []*big.Int) B) AoAoBigI {
+	return AoAoBigI(Grid[*big.Int](m).checkZero("Filter", "AoAoBigI").Filter(toMaybe(f)))
+}
+
+// Reject is the opposite of Filter: it returns an AoAoBigI of the rows of a
+// valid AoAoBigI for which a function returns false.
+func (m AoAoBigI) Reject(f func(x []*big.Int) B) AoAoBigI {
+	return AoAoBigI(Grid[*big.Int](m).checkZero("Reject", "AoAoBigI").Reject(toMaybe(f)))
+}
+
+// Partition splits a valid AoAoBigI into the rows for which a function returns
+// true and those for which it returns false.  If the AoAoBigI is invalid or if
+// any function returns an invalid B, both results are invalid.
+func (m AoAoBigI) Partition(f func(x []*big.Int) B) (AoAoBigI, AoAoBigI) {
+	in, out := Grid[*big.Int](m).checkZero("Partition", "AoAoBigI").Partition(toMaybe(f))
+	return AoAoBigI(in), AoAoBigI(out)
+}
+
+// IsNothing returns true for an AoAoBigI that holds nothing, i.e. one whose
+// error is ErrNothing itself.  Such an AoAoBigI is also invalid, so IsErr
+// returns true.  An error that only wraps ErrNothing, such as an ElementError
+// for a missing element, is an ordinary error.
+func (m AoAoBigI) IsNothing() bool {
+	return Grid[*big.Int](m).IsNothing()
+}
+
+// JustOr returns a valid AoAoBigI holding a default value if the AoAoBigI holds
+// nothing, or otherwise returns the AoAoBigI unchanged.  Unlike OrElse, it
+// leaves other errors in place.  A nil default is replaced by an empty one, as
+// for OrElse.
+func (m AoAoBigI) JustOr(x [][]*big.Int) AoAoBigI {
+	return AoAoBigI(Grid[*big.Int](m).JustOr(x))
+}
+
+// OrElse returns the AoAoBigI if it is valid, or otherwise a valid AoAoBigI
+// holding a default value.  A nil default is replaced by an empty one, so the
+// result is always valid.
+func (m AoAoBigI) OrElse(x [][]*big.Int) AoAoBigI {
+	return AoAoBigI(Grid[*big.Int](m).OrElse(x))
+}
+
+// Recover returns the AoAoBigI if it is valid, or otherwise a valid AoAoBigI
+// holding the value returned by a function that takes the error, with nil
+// replaced by an empty value as for OrElse.
+func (m AoAoBigI) Recover(f func(err error) [][]*big.Int) AoAoBigI {
+	return AoAoBigI(Grid[*big.Int](m).checkZero("Unbox", "AoAoBigI").Recover(f))
+}
+
+// MapErr returns the AoAoBigI if it is valid, or otherwise an invalid AoAoBigI
+// with the error returned by a function that takes the original error, e.g. to
+// add context with fmt.Errorf and %w.  If the function returns nil, the
+// original error is kept.
+func (m AoAoBigI) MapErr(f func(err error) error) AoAoBigI {
+	return AoAoBigI(Grid[*big.Int](m).checkZero("Unbox", "AoAoBigI").MapErr(f))
+}
+
+// Catch is like Recover, but only for errors that match a target according to
+// errors.Is, and the function returns an AoAoBigI, so it may fail again.  Valid
+// values and other errors are returned unchanged.
+func (m AoAoBigI) Catch(target error, f func(err error) AoAoBigI) AoAoBigI {
+	return AoAoBigI(Grid[*big.Int](m).checkZero("Unbox", "AoAoBigI").Catch(target, toGrid(f)))
+}
+
+// MarshalJSON implements json.Marshaler using the wire format described in
+// the package documentation.
+func (m AoAoBigI) MarshalJSON() ([]byte, error) {
+	return marshalJSON(m.Unbox())
+}
+
+// UnmarshalJSON implements json.Unmarshaler using the wire format described
+// in the package documentation.
+func (m *AoAoBigI) UnmarshalJSON(b []byte) error {
+	return unmarshalJSON(b, (*Maybe[[][]*big.Int])(m), "AoAoBigI")
+}
+
+// String returns a string representation, mostly useful for debugging.
+func (m AoAoBigI) String() string {
+	if m.IsErr() {
+		return fmt.Sprintf("Err %v", m.err)
+	}
+	return fmt.Sprintf("Just %v", m.just)
+}
+
+// Unbox returns the underlying 2-D slice of *big.Ints or error.
+func (m AoAoBigI) Unbox() ([][]*big.Int, error) {
+	if m.just == nil && m.err == nil {
+		return nil, &OpError{Op: "Unbox", Type: "AoAoBigI", Err: ErrZeroValue}
+	}
+	return m.just, m.err
+}
@@ -0,0 +1,42 @@
+package maybe_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/xdg/maybe"
+	"github.com/xdg/testy"
+)
+
+func TestAoAoBigI(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	input := [][]*big.Int{{big.NewInt(1)}, {big.NewInt(2), big.NewInt(3)}}
+	good := maybe.JustAoAoBigI(input)
+	bad := maybe.ErrAoAoBigI(errors.New("bad big ints"))
+
+	just, err := good.Unbox()
+	is.Equal(just, input)
+	is.Nil(err)
+	is.False(good.IsErr())
+
+	just, err = bad.Unbox()
+	is.Nil(just)
+	is.Equal(err.Error(), "bad big ints")
+	is.True(bad.IsErr())
+
+	is.Equal(maybe.NewAoAoBigI(input, nil), good)
+	is.True(maybe.NewAoAoBigI(nil, err).IsErr())
+	is.Equal(good.String(), "Just [[1] [2 3]]")
+
+	flat, err := good.Flatten().Unbox()
+	is.Equal(len(flat), 3)
+	is.Nil(err)
+
+	zero := maybe.AoAoBigI{}
+	is.True(zero.IsErr())
+	_, err = zero.Unbox()
+	is.NotNil(err)
+}
@@ -0,0 +1,181 @@
+package maybe
+
+import (
+	"fmt"
+	"time"
+)
+
+// AoAoD implements the Maybe monad for a 2-D slice of durations.  An AoAoD is
+// considered 'valid' or 'invalid' depending on whether it contains a 2-D
+// slice of durations or an error value.  A zero-value AoAoD is invalid and
+// Unbox() will return an error to that effect.
+type AoAoD Grid[time.Duration]
+
+// NewAoAoD constructs an AoAoD from a given 2-D slice of durations or error.
+// If e is not nil, returns ErrAoAoD(e), otherwise returns JustAoAoD(x).
+func NewAoAoD(x [][]time.Duration, e error) AoAoD {
+	if e != nil {
+		return ErrAoAoD(e)
+	}
+	return JustAoAoD(x)
+}
+
+// JustAoAoD constructs a valid AoAoD from a given 2-D slice of durations.
+func JustAoAoD(x [][]time.Duration) AoAoD {
+	return AoAoD{just: x}
+}
+
+// ErrAoAoD constructs an invalid AoAoD from a given error.
+func ErrAoAoD(e error) AoAoD {
+	return AoAoD{err: e}
+}
+
+// NothingAoAoD constructs an invalid AoAoD that holds nothing, for a missing
+// rather than a failed value.  See ErrNothing.
+func NothingAoAoD() AoAoD {
+	return AoAoD{err: ErrNothing}
+}
+
+// IsErr returns true for an invalid AoAoD.
+func (m AoAoD) IsErr() bool {
+	return m.just == nil || m.err != nil
+}
+
+// Bind applies a function that takes a 2-D slice of durations and returns an
+// AoAoD.
+func (m AoAoD) Bind(f func(x [][]time.Duration) AoAoD) AoAoD {
+	if m.IsErr() {
+		return m
+	}
+
+	return try(f, m.just, ErrAoAoD)
+}
+
+// Join applies a function to each row of a valid AoAoD and returns an AoD
+// of the results.  If the AoAoD is invalid or if any function returns an
+// invalid D, Join returns an invalid AoD.
+func (m AoAoD) Join(f func(x []time.Duration) D) AoD {
+	return AoD(JoinGrid(Grid[time.Duration](m).checkZero("Join", "AoAoD"), toMaybe(f)))
+}
+
+// Flatten joins a 2-D slice of durations into a 1-D slice
+func (m AoAoD) Flatten() AoD {
+	return AoD(Grid[time.Duration](m).checkZero("Flatten", "AoAoD").Flatten())
+}
+
+// Map applies a function to each element of a valid AoAoD (i.e. a 1-D slice)
+// and returns a new AoAoD.  If the AoAoD is invalid or if any function
+// returns an invalid AoD, Map returns an invalid AoAoD.
+func (m AoAoD) Map(f func(x []time.Duration) AoD) AoAoD {
+	return AoAoD(MapGrid(Grid[time.Duration](m).checkZero("Map", "AoAoD"), toSlice(f)))
+}
+
+// Filter returns an AoAoD of the rows of a valid AoAoD for which a function
+// returns true, in order.  If the AoAoD is invalid or if any function returns
+// an invalid B, Filter returns an invalid AoAoD.
+func (m AoAoD) Filter(f func(x []time.Duration) B) AoAoD {
+	return AoAoD(Grid[time.Duration](m).checkZero("Filter", "AoAoD").Filter(toMaybe(f)))
+}
+
+// Reject is the opposite of Filter: it returns an AoAoD of the rows of a valid
+// AoAoD for which a function returns false.
+func (m AoAoD) Reject(f func(x []time.Duration) B) AoAoD {
+	return AoAoD(Grid[time.Duration](m).checkZero("Reject", "AoAoD").Reject(toMaybe(f)))
+}
+
+// Partition splits a valid AoAoD into the rows for which a function returns
+// true and those for which it returns false.  If the AoAoD is invalid or if any
+// function returns an invalid B, both results are invalid.
+func (m AoAoD) Partition(f func(x []time.Duration) B) (AoAoD, AoAoD) {
+	in, out := Grid[time.Duration](m).checkZero("Partition", "AoAoD").Partition(toMaybe(f))
+	return AoAoD(in), AoAoD(out)
+}
+
+// Format formats each individual element of a valid AoAoD as
+// time.Duration.String does, resulting in an AoAoS.  If the AoAoD is invalid,
+// Format returns an invalid AoAoS.
+func (m AoAoD) Format() AoAoS {
+	return AoAoS(MapCells(Grid[time.Duration](m).checkZero("Format", "AoAoD"), func(x time.Duration) Maybe[string] {
+		return Just(x.String())
+	}))
+}
+
+// Sum returns an AoD with the total of the durations in each row of a valid
+// AoAoD.  If the AoAoD is invalid or the total of any row overflows
+// time.Duration, Sum returns an invalid AoD.
+func (m AoAoD) Sum() AoD {
+	return m.Join(func(xs []time.Duration) D { return JustAoD(xs).Sum() })
+}
+
+// IsNothing returns true for an AoAoD that holds nothing, i.e. one whose error
+// is ErrNothing itself.  Such an AoAoD is also invalid, so IsErr returns true.
+// An error that only wraps ErrNothing, such as an ElementError for a missing
+// element, is an ordinary error.
+func (m AoAoD) IsNothing() bool {
+	return Grid[time.Duration](m).IsNothing()
+}
+
+// JustOr returns a valid AoAoD holding a default value if the AoAoD holds
+// nothing, or otherwise returns the AoAoD unchanged.  Unlike OrElse, it leaves
+// other errors in place.  A nil default is replaced by an empty one, as for
+// OrElse.
+func (m AoAoD) JustOr(x [][]time.Duration) AoAoD {
+	return AoAoD(Grid[time.Duration](m).JustOr(x))
+}
+
+// OrElse returns the AoAoD if it is valid, or otherwise a valid AoAoD holding a
+// default value.  A nil default is replaced by an empty one, so the result is
+// always valid.
+func (m AoAoD) OrElse(x [][]time.Duration) AoAoD {
+	return AoAoD(Grid[time.Duration](m).OrElse(x))
+}
+
+// Recover returns the AoAoD if it is valid, or otherwise a valid AoAoD holding
+// the value returned by a function that takes the error, with nil replaced by
+// an empty value as for OrElse.
+func (m AoAoD) Recover(f func(err error) [][]time.Duration) AoAoD {
+	return AoAoD(Grid[time.Duration](m).checkZero("Unbox", "AoAoD").Recover(f))
+}
+
+// MapErr returns the AoAoD if it is valid, or otherwise an invalid AoAoD with
+// the error returned by a function that takes the original error, e.g. to add
+// context with fmt.Errorf and %w.  If the function returns nil, the original
+// error is kept.
+func (m AoAoD) MapErr(f func(err error) error) AoAoD {
+	return AoAoD(Grid[time.Duration](m).checkZero("Unbox", "AoAoD").MapErr(f))
+}
+
+// Catch is like Recover, but only for errors that match a target according
+// to errors.Is, and the function returns an AoAoD, so it may fail again.  Valid
+// values and other errors are returned unchanged.
+func (m AoAoD) Catch(target error, f func(err error) AoAoD) AoAoD {
+	return AoAoD(Grid[time.Duration](m).checkZero("Unbox", "AoAoD").Catch(target, toGrid(f)))
+}
+
+// MarshalJSON implements json.Marshaler using the wire format described in
+// the package documentation.
+func (m AoAoD) MarshalJSON() ([]byte, error) {
+	return marshalJSON(m.Unbox())
+}
+
+// UnmarshalJSON implements json.Unmarshaler using the wire format described
+// in the package documentation.
+func (m *AoAoD) UnmarshalJSON(b []byte) error {
+	return unmarshalJSON(b, (*Maybe[[][]time.Duration])(m), "AoAoD")
+}
+
+// String returns a string representation, mostly useful for debugging.
+func (m AoAoD) String() string {
+	if m.IsErr() {
+		return fmt.Sprintf("Err %v", m.err)
+	}
+	return fmt.Sprintf("Just %v", m.just)
+}
+
+// Unbox returns the underlying 2-D slice of durations or error.
+func (m AoAoD) Unbox() ([][]time.Duration, error) {
+	if m.just == nil && m.err == nil {
+		return nil, &OpError{Op: "Unbox", Type: "AoAoD", Err: ErrZeroValue}
+	}
+	return m.just, m.err
+}
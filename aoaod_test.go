@@ -0,0 +1,36 @@
+package maybe_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/xdg/maybe"
+	"github.com/xdg/testy"
+)
+
+func TestAoAoD(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	good := maybe.JustAoAoS([][]string{{"1m", "2m"}, {}, {"1h"}}).ToDuration()
+	xss, err := good.Unbox()
+	is.Equal(xss, [][]time.Duration{{time.Minute, 2 * time.Minute}, {}, {time.Hour}})
+	is.Nil(err)
+
+	sums, err := good.Sum().Unbox()
+	is.Equal(sums, []time.Duration{3 * time.Minute, 0, time.Hour})
+	is.Nil(err)
+
+	strs, err := good.Format().Unbox()
+	is.Equal(strs, [][]string{{"1m0s", "2m0s"}, {}, {"1h0m0s"}})
+	is.Nil(err)
+
+	is.True(maybe.JustAoAoS([][]string{{"1m", "x"}}).ToDuration().IsErr())
+
+	bad := maybe.ErrAoAoD(errors.New("bad durations"))
+	is.Equal(bad.String(), "Err bad durations")
+	is.True(bad.Sum().IsErr())
+	is.True(bad.Format().IsErr())
+	is.True(maybe.AoAoD{}.IsErr())
+}
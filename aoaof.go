@@ -0,0 +1,170 @@
+package maybe
+
+import "fmt"
+
+// AoAoF implements the Maybe monad for a 2-D slice of float64s.  An AoAoF is
+// considered 'valid' or 'invalid' depending on whether it contains a 2-D
+// slice of float64s or an error value.  A zero-value AoAoF is invalid and
+// Unbox() will return an error to that effect.
+type AoAoF Grid[float64]
+
+// NewAoAoF constructs an AoAoF from a given 2-D slice of float64s or error.
+// If e is not nil, returns ErrAoAoF(e), otherwise returns JustAoAoF(x).
+func NewAoAoF(x [][]float64, e error) AoAoF {
+	if e != nil {
+		return ErrAoAoF(e)
+	}
+	return JustAoAoF(x)
+}
+
+// JustAoAoF constructs a valid AoAoF from a given 2-D slice of float64s.
+func JustAoAoF(x [][]float64) AoAoF {
+	return AoAoF{just: x}
+}
+
+// ErrAoAoF constructs an invalid AoAoF from a given error.
+func ErrAoAoF(e error) AoAoF {
+	return AoAoF{err: e}
+}
+
+// NothingAoAoF constructs an invalid AoAoF that holds nothing, for a missing
+// rather than a failed value.  See ErrNothing.
+func NothingAoAoF() AoAoF {
+	return AoAoF{err: ErrNothing}
+}
+
+// IsErr returns true for an invalid AoAoF.
+func (m AoAoF) IsErr() bool {
+	return m.just == nil || m.err != nil
+}
+
+// Bind applies a function that takes a 2-D slice of float64s and returns an
+// AoAoF.
+func (m AoAoF) Bind(f func(x [][]float64) AoAoF) AoAoF {
+	if m.IsErr() {
+		return m
+	}
+
+	return try(f, m.just, ErrAoAoF)
+}
+
+// Join applies a function to each row of a valid AoAoF and returns an AoF
+// of the results.  If the AoAoF is invalid or if any function returns an
+// invalid F, Join returns an invalid AoF.
+func (m AoAoF) Join(f func(x []float64) F) AoF {
+	return AoF(JoinGrid(Grid[float64](m).checkZero("Join", "AoAoF"), toMaybe(f)))
+}
+
+// Flatten joins a 2-D slice of float64s into a 1-D slice
+func (m AoAoF) Flatten() AoF {
+	return AoF(Grid[float64](m).checkZero("Flatten", "AoAoF").Flatten())
+}
+
+// Map applies a function to each element of a valid AoAoF (i.e. a 1-D slice)
+// and returns a new AoAoF.  If the AoAoF is invalid or if any function
+// returns an invalid AoF, Map returns an invalid AoAoF.
+func (m AoAoF) Map(f func(x []float64) AoF) AoAoF {
+	return AoAoF(MapGrid(Grid[float64](m).checkZero("Map", "AoAoF"), toSlice(f)))
+}
+
+// Filter returns an AoAoF of the rows of a valid AoAoF for which a function
+// returns true, in order.  If the AoAoF is invalid or if any function returns
+// an invalid B, Filter returns an invalid AoAoF.
+func (m AoAoF) Filter(f func(x []float64) B) AoAoF {
+	return AoAoF(Grid[float64](m).checkZero("Filter", "AoAoF").Filter(toMaybe(f)))
+}
+
+// Reject is the opposite of Filter: it returns an AoAoF of the rows of a valid
+// AoAoF for which a function returns false.
+func (m AoAoF) Reject(f func(x []float64) B) AoAoF {
+	return AoAoF(Grid[float64](m).checkZero("Reject", "AoAoF").Reject(toMaybe(f)))
+}
+
+// Partition splits a valid AoAoF into the rows for which a function returns
+// true and those for which it returns false.  If the AoAoF is invalid or if any
+// function returns an invalid B, both results are invalid.
+func (m AoAoF) Partition(f func(x []float64) B) (AoAoF, AoAoF) {
+	in, out := Grid[float64](m).checkZero("Partition", "AoAoF").Partition(toMaybe(f))
+	return AoAoF(in), AoAoF(out)
+}
+
+// ToStr applies a function that takes a float64 and returns an S.  If the
+// AoAoF is invalid or if any function returns an invalid S, ToStr returns an
+// invalid AoAoS.  Note: unlike Map, this is a deep conversion of individual
+// elements of the 2-D slice of float64s.
+func (m AoAoF) ToStr(f func(x float64) S) AoAoS {
+	return AoAoS(MapCells(Grid[float64](m).checkZero("ToStr", "AoAoF"), toMaybe(f)))
+}
+
+// IsNothing returns true for an AoAoF that holds nothing, i.e. one whose error
+// is ErrNothing itself.  Such an AoAoF is also invalid, so IsErr returns true.
+// An error that only wraps ErrNothing, such as an ElementError for a missing
+// element, is an ordinary error.
+func (m AoAoF) IsNothing() bool {
+	return Grid[float64](m).IsNothing()
+}
+
+// JustOr returns a valid AoAoF holding a default value if the AoAoF holds
+// nothing, or otherwise returns the AoAoF unchanged.  Unlike OrElse, it leaves
+// other errors in place.  A nil default is replaced by an empty one, as for
+// OrElse.
+func (m AoAoF) JustOr(x [][]float64) AoAoF {
+	return AoAoF(Grid[float64](m).JustOr(x))
+}
+
+// OrElse returns the AoAoF if it is valid, or otherwise a valid AoAoF holding a
+// default value.  A nil default is replaced by an empty one, so the result is
+// always valid.
+func (m AoAoF) OrElse(x [][]float64) AoAoF {
+	return AoAoF(Grid[float64](m).OrElse(x))
+}
+
+// Recover returns the AoAoF if it is valid, or otherwise a valid AoAoF holding
+// the value returned by a function that takes the error, with nil replaced by
+// an empty value as for OrElse.
+func (m AoAoF) Recover(f func(err error) [][]float64) AoAoF {
+	return AoAoF(Grid[float64](m).checkZero("Unbox", "AoAoF").Recover(f))
+}
+
+// MapErr returns the AoAoF if it is valid, or otherwise an invalid AoAoF with
+// the error returned by a function that takes the original error, e.g. to add
+// context with fmt.Errorf and %w.  If the function returns nil, the original
+// error is kept.
+func (m AoAoF) MapErr(f func(err error) error) AoAoF {
+	return AoAoF(Grid[float64](m).checkZero("Unbox", "AoAoF").MapErr(f))
+}
+
+// Catch is like Recover, but only for errors that match a target according
+// to errors.Is, and the function returns an AoAoF, so it may fail again.  Valid
+// values and other errors are returned unchanged.
+func (m AoAoF) Catch(target error, f func(err error) AoAoF) AoAoF {
+	return AoAoF(Grid[float64](m).checkZero("Unbox", "AoAoF").Catch(target, toGrid(f)))
+}
+
+// MarshalJSON implements json.Marshaler using the wire format described in
+// the package documentation.
+func (m AoAoF) MarshalJSON() ([]byte, error) {
+	return marshalJSON(m.Unbox())
+}
+
+// UnmarshalJSON implements json.Unmarshaler using the wire format described
+// in the package documentation.
+func (m *AoAoF) UnmarshalJSON(b []byte) error {
+	return unmarshalJSON(b, (*Maybe[[][]float64])(m), "AoAoF")
+}
+
+// String returns a string representation, mostly useful for debugging.
+func (m AoAoF) String() string {
+	if m.IsErr() {
+		return fmt.Sprintf("Err %v", m.err)
+	}
+	return fmt.Sprintf("Just %v", m.just)
+}
+
+// Unbox returns the underlying 2-D slice of float64s or error.
+func (m AoAoF) Unbox() ([][]float64, error) {
+	if m.just == nil && m.err == nil {
+		return nil, &OpError{Op: "Unbox", Type: "AoAoF", Err: ErrZeroValue}
+	}
+	return m.just, m.err
+}
@@ -0,0 +1,111 @@
+package maybe_test
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/xdg/maybe"
+	"github.com/xdg/testy"
+)
+
+func getAoAoFFixtures(input [][]float64) (good, bad maybe.AoAoF) {
+	good = maybe.JustAoAoF(input)
+	bad = maybe.ErrAoAoF(errors.New("bad floats"))
+	return
+}
+
+func TestAoAoF(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	input := [][]float64{{2.5, 4.2}, {1.5}}
+	good, bad := getAoAoFFixtures(input)
+	var got maybe.AoAoF
+	var just [][]float64
+	var err error
+
+	just, err = good.Unbox()
+	is.Equal(just, input)
+	is.Nil(err)
+	is.False(good.IsErr())
+
+	just, err = bad.Unbox()
+	is.Nil(just)
+	is.NotNil(err)
+	is.Equal(err.Error(), "bad floats")
+	is.True(bad.IsErr())
+
+	got = maybe.NewAoAoF(input, nil)
+	is.Equal(got, good)
+
+	got = maybe.NewAoAoF(nil, err)
+	is.True(got.IsErr())
+
+	is.Equal(good.String(), "Just [[2.5 4.2] [1.5]]")
+	is.Equal(bad.String(), "Err bad floats")
+
+	zero := maybe.AoAoF{}
+	is.True(zero.IsErr())
+	_, err = zero.Unbox()
+	is.NotNil(err)
+}
+
+func TestAoAoFOperations(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	good, bad := getAoAoFFixtures([][]float64{{2.5, 4.2}, {1.5}})
+
+	tail := func(xss [][]float64) maybe.AoAoF { return maybe.JustAoAoF(xss[1:]) }
+	just, err := good.Bind(tail).Unbox()
+	is.Equal(just, [][]float64{{1.5}})
+	is.Nil(err)
+	is.True(bad.Bind(tail).IsErr())
+
+	first := func(xs []float64) maybe.F { return maybe.JustF(xs[0]) }
+	firsts, err := good.Join(first).Unbox()
+	is.Equal(firsts, []float64{2.5, 1.5})
+	is.Nil(err)
+	is.True(bad.Join(first).IsErr())
+
+	flat, err := good.Flatten().Unbox()
+	is.Equal(flat, []float64{2.5, 4.2, 1.5})
+	is.Nil(err)
+	is.True(bad.Flatten().IsErr())
+
+	rev := func(xs []float64) maybe.AoF {
+		out := make([]float64, len(xs))
+		for i, v := range xs {
+			out[len(xs)-1-i] = v
+		}
+		return maybe.JustAoF(out)
+	}
+	just, err = good.Map(rev).Unbox()
+	is.Equal(just, [][]float64{{4.2, 2.5}, {1.5}})
+	is.Nil(err)
+	is.True(bad.Map(rev).IsErr())
+}
+
+func TestAoAoFConversions(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	parse := func(s string) maybe.F { return maybe.NewF(strconv.ParseFloat(s, 64)) }
+	format := func(x float64) maybe.S { return maybe.JustS(strconv.FormatFloat(x, 'f', -1, 64)) }
+
+	just, err := maybe.JustAoAoS([][]string{{"2.5", "4.2"}, {"1"}}).ToFloat(parse).Unbox()
+	is.Equal(just, [][]float64{{2.5, 4.2}, {1}})
+	is.Nil(err)
+	is.True(maybe.JustAoAoS([][]string{{"x"}}).ToFloat(parse).IsErr())
+
+	strs, err := maybe.JustAoAoF([][]float64{{2.5}, {4.2}}).ToStr(format).Unbox()
+	is.Equal(strs, [][]string{{"2.5"}, {"4.2"}})
+	is.Nil(err)
+	is.True(maybe.ErrAoAoF(errors.New("bad floats")).ToStr(format).IsErr())
+
+	toFloat := func(x int) maybe.F { return maybe.JustF(float64(x)) }
+	just, err = maybe.JustAoAoI([][]int{{1}, {2, 3}}).ToFloat(toFloat).Unbox()
+	is.Equal(just, [][]float64{{1}, {2, 3}})
+	is.Nil(err)
+}
@@ -1,7 +1,7 @@
 package maybe
 
 import (
-	"errors"
+	"context"
 	"fmt"
 )
 
@@ -9,10 +9,7 @@ import (
 // considered 'valid' or 'invalid' depending on whether it contains a 2-D
 // slice of ints or an error value.  A zero-value AoAoI is invalid and Unbox()
 // will return an error to that effect.
-type AoAoI struct {
-	just [][]int
-	err  error
-}
+type AoAoI Grid[int]
 
 // NewAoAoI constructs an AoAoI from a given 2-D slice of ints or error. If e is not
 // nil, returns ErrAoAoI(e), otherwise returns JustAoAoI(s).
@@ -33,6 +30,12 @@ func ErrAoAoI(e error) AoAoI {
 	return AoAoI{err: e}
 }
 
+// NothingAoAoI constructs an invalid AoAoI that holds nothing, for a missing
+// rather than a failed value.  See ErrNothing.
+func NothingAoAoI() AoAoI {
+	return AoAoI{err: ErrNothing}
+}
+
 // IsErr returns true for an invalid AoAoI.
 func (m AoAoI) IsErr() bool {
 	return m.just == nil || m.err != nil
@@ -44,59 +47,179 @@ func (m AoAoI) Bind(f func(s [][]int) AoAoI) AoAoI {
 		return m
 	}
 
-	return f(m.just)
+	return try(f, m.just, ErrAoAoI)
 }
 
-// Join applies a function that takes a 2-D slice of ints and returns an AoI.
-func (m AoAoI) Join(f func(s []int) I) AoI {
+// BindCtx is like Bind, but the function also takes a context.  If the
+// context is done, BindCtx returns an invalid AoAoI with the context's error
+// instead of calling the function.
+func (m AoAoI) BindCtx(ctx context.Context, f func(ctx context.Context, s [][]int) AoAoI) AoAoI {
 	if m.IsErr() {
-		return ErrAoI(m.err)
+		return m
 	}
-
-	xss := make([]int, len(m.just))
-	for i, v := range m.just {
-		s, err := f(v).Unbox()
-		if err != nil {
-			return ErrAoI(err)
-		}
-		xss[i] = s
+	if err := ctx.Err(); err != nil {
+		return ErrAoAoI(err)
 	}
 
-	return JustAoI(xss)
+	return tryCtx(ctx, f, m.just, ErrAoAoI)
 }
 
-// Flatten joins a 2-D slice of ints into a 1-D slice
-func (m AoAoI) Flatten() AoI {
-	if m.IsErr() {
-		return ErrAoI(m.err)
-	}
+// Join applies a function that takes a 2-D slice of ints and returns an AoI.
+func (m AoAoI) Join(f func(s []int) I) AoI {
+	return AoI(JoinGrid(Grid[int](m).checkZero("Join", "AoAoI"), toMaybe(f)))
+}
 
-	xs := make([]int, 0)
-	for _, v := range m.just {
-		xs = append(xs, v...)
-	}
+// JoinCtx is like Join, but the function also takes a context.  The context
+// is checked before each row; once it is done, JoinCtx stops and returns an
+// invalid AoI with the context's error.
+func (m AoAoI) JoinCtx(ctx context.Context, f func(ctx context.Context, s []int) I) AoI {
+	return AoI(JoinGridCtx(ctx, Grid[int](m).checkZero("JoinCtx", "AoAoI"), toMaybeCtx(f)))
+}
+
+// JoinAll is like Join, but applies the function to every row even after a
+// failure.  If any function returns an invalid I, JoinAll returns an
+// invalid AoI whose error joins the errors from all failing rows, each tagged
+// with its row index.
+func (m AoAoI) JoinAll(f func(s []int) I) AoI {
+	return AoI(JoinGridAll(Grid[int](m).checkZero("JoinAll", "AoAoI"), toMaybe(f)))
+}
 
-	return JustAoI(xs)
+// Flatten joins a 2-D slice of ints into a 1-D slice
+func (m AoAoI) Flatten() AoI {
+	return AoI(Grid[int](m).checkZero("Flatten", "AoAoI").Flatten())
 }
 
 // Map applies a function to each element of a valid AoAoI (i.e. a 1-D slice)
 // and returns a new AoAoI.  If the AoAoI is invalid or if any function
 // returns an invalid AoI, Map returns an invalid AoAoI.
 func (m AoAoI) Map(f func(s []int) AoI) AoAoI {
-	if m.IsErr() {
-		return m
-	}
+	return AoAoI(MapGrid(Grid[int](m).checkZero("Map", "AoAoI"), toSlice(f)))
+}
 
-	xss := make([][]int, len(m.just))
-	for i, v := range m.just {
-		x, err := f(v).Unbox()
-		if err != nil {
-			return ErrAoAoI(err)
-		}
-		xss[i] = x
-	}
+// Filter returns an AoAoI of the rows of a valid AoAoI for which a function
+// returns true, in order.  If the AoAoI is invalid or if any function returns
+// an invalid B, Filter returns an invalid AoAoI.
+func (m AoAoI) Filter(f func(s []int) B) AoAoI {
+	return AoAoI(Grid[int](m).checkZero("Filter", "AoAoI").Filter(toMaybe(f)))
+}
+
+// Reject is the opposite of Filter: it returns an AoAoI of the rows of a valid
+// AoAoI for which a function returns false.
+func (m AoAoI) Reject(f func(s []int) B) AoAoI {
+	return AoAoI(Grid[int](m).checkZero("Reject", "AoAoI").Reject(toMaybe(f)))
+}
+
+// Partition splits a valid AoAoI into the rows for which a function returns
+// true and those for which it returns false.  If the AoAoI is invalid or if any
+// function returns an invalid B, both results are invalid.
+func (m AoAoI) Partition(f func(s []int) B) (AoAoI, AoAoI) {
+	in, out := Grid[int](m).checkZero("Partition", "AoAoI").Partition(toMaybe(f))
+	return AoAoI(in), AoAoI(out)
+}
+
+// FoldRows folds each row of a valid AoAoI into a single value, as AoI.Fold
+// does, resulting in an AoI with one value per row.  If the AoAoI is invalid or
+// if any function returns an invalid I, FoldRows returns an invalid AoI.
+func (m AoAoI) FoldRows(init int, f func(acc int, s int) I) AoI {
+	return AoI(FoldGridRows(Grid[int](m).checkZero("FoldRows", "AoAoI"), init, toMaybe2(f)))
+}
+
+// FoldCols folds each column of a valid AoAoI into a single value, going down
+// the rows, resulting in an AoI with one value per column.  All rows must
+// have the same length; otherwise, FoldCols returns an invalid AoI with an
+// error wrapping ErrRagged.  If the AoAoI is invalid or if any function returns
+// an invalid I, FoldCols returns an invalid AoI.
+func (m AoAoI) FoldCols(init int, f func(acc int, s int) I) AoI {
+	return AoI(FoldGridCols(Grid[int](m).checkZero("FoldCols", "AoAoI"), init, toMaybe2(f)))
+}
 
-	return JustAoAoI(xss)
+// Unzip is the opposite of AoI.Zip: it splits a valid AoAoI whose rows each
+// hold exactly two elements into an AoI of the first elements and an AoI of the
+// second elements.  If the AoAoI is invalid or any row has a different
+// length, both results are invalid.
+func (m AoAoI) Unzip() (AoI, AoI) {
+	xs, ys := Grid[int](m).checkZero("Unzip", "AoAoI").Unzip()
+	return AoI(xs), AoI(ys)
+}
+
+// MapCtx is like Map, but the function also takes a context.  The context is
+// checked before each row; once it is done, MapCtx stops and returns an
+// invalid AoAoI with the context's error.
+func (m AoAoI) MapCtx(ctx context.Context, f func(ctx context.Context, s []int) AoI) AoAoI {
+	return AoAoI(MapGridCtx(ctx, Grid[int](m).checkZero("MapCtx", "AoAoI"), toSliceCtx(f)))
+}
+
+// MapAll is like Map, but applies the function to every row even after a
+// failure.  If any function returns an invalid AoI, MapAll returns an
+// invalid AoAoI whose error joins the errors from all failing rows, each
+// tagged with its row index.
+func (m AoAoI) MapAll(f func(s []int) AoI) AoAoI {
+	return AoAoI(MapGridAll(Grid[int](m).checkZero("MapAll", "AoAoI"), toSlice(f)))
+}
+
+// ParallelMap is like Map, but runs the function on up to n rows
+// concurrently, or GOMAXPROCS rows if n is less than 1.  The order of rows is
+// preserved.  Once a function returns an invalid AoI, no further rows are
+// started and ParallelMap returns an invalid AoAoI.
+func (m AoAoI) ParallelMap(n int, f func(s []int) AoI) AoAoI {
+	return AoAoI(ParallelMapGrid(Grid[int](m).checkZero("ParallelMap", "AoAoI"), n, toSlice(f)))
+}
+
+// IsNothing returns true for an AoAoI that holds nothing, i.e. one whose error
+// is ErrNothing itself.  Such an AoAoI is also invalid, so IsErr returns true.
+// An error that only wraps ErrNothing, such as an ElementError for a missing
+// element, is an ordinary error.
+func (m AoAoI) IsNothing() bool {
+	return Grid[int](m).IsNothing()
+}
+
+// JustOr returns a valid AoAoI holding a default value if the AoAoI holds
+// nothing, or otherwise returns the AoAoI unchanged.  Unlike OrElse, it leaves
+// other errors in place.  A nil default is replaced by an empty one, as for
+// OrElse.
+func (m AoAoI) JustOr(x [][]int) AoAoI {
+	return AoAoI(Grid[int](m).JustOr(x))
+}
+
+// OrElse returns the AoAoI if it is valid, or otherwise a valid AoAoI holding a
+// default value.  A nil default is replaced by an empty one, so the result is
+// always valid.
+func (m AoAoI) OrElse(x [][]int) AoAoI {
+	return AoAoI(Grid[int](m).OrElse(x))
+}
+
+// Recover returns the AoAoI if it is valid, or otherwise a valid AoAoI holding
+// the value returned by a function that takes the error, with nil replaced by
+// an empty value as for OrElse.
+func (m AoAoI) Recover(f func(err error) [][]int) AoAoI {
+	return AoAoI(Grid[int](m).checkZero("Unbox", "AoAoI").Recover(f))
+}
+
+// MapErr returns the AoAoI if it is valid, or otherwise an invalid AoAoI with
+// the error returned by a function that takes the original error, e.g. to add
+// context with fmt.Errorf and %w.  If the function returns nil, the original
+// error is kept.
+func (m AoAoI) MapErr(f func(err error) error) AoAoI {
+	return AoAoI(Grid[int](m).checkZero("Unbox", "AoAoI").MapErr(f))
+}
+
+// Catch is like Recover, but only for errors that match a target according
+// to errors.Is, and the function returns an AoAoI, so it may fail again.  Valid
+// values and other errors are returned unchanged.
+func (m AoAoI) Catch(target error, f func(err error) AoAoI) AoAoI {
+	return AoAoI(Grid[int](m).checkZero("Unbox", "AoAoI").Catch(target, toGrid(f)))
+}
+
+// MarshalJSON implements json.Marshaler using the wire format described in
+// the package documentation.
+func (m AoAoI) MarshalJSON() ([]byte, error) {
+	return marshalJSON(m.Unbox())
+}
+
+// UnmarshalJSON implements json.Unmarshaler using the wire format described
+// in the package documentation.
+func (m *AoAoI) UnmarshalJSON(b []byte) error {
+	return unmarshalJSON(b, (*Maybe[[][]int])(m), "AoAoI")
 }
 
 // String returns a string representation, mostly useful for debugging.
@@ -112,29 +235,50 @@ func (m AoAoI) String() string {
 // invalid AoAoS.  Note: unlike Map, this is a deep conversion of individual
 // elements of the 2-D slice of ints.
 func (m AoAoI) ToStr(f func(x int) S) AoAoS {
-	if m.IsErr() {
-		return ErrAoAoS(m.err)
-	}
+	return AoAoS(MapCells(Grid[int](m).checkZero("ToStr", "AoAoI"), toMaybe(f)))
+}
 
-	xss := make([][]string, len(m.just))
-	for i, xs := range m.just {
-		xss[i] = make([]string, len(xs))
-		for j, v := range xs {
-			num, err := f(v).Unbox()
-			if err != nil {
-				return ErrAoAoS(err)
-			}
-			xss[i][j] = num
-		}
-	}
+// ToFloat applies a function that takes an int and returns an F.  If the
+// AoAoI is invalid or if any function returns an invalid F, ToFloat returns
+// an invalid AoAoF.  Note: unlike Map, this is a deep conversion of
+// individual elements of the 2-D slice.
+func (m AoAoI) ToFloat(f func(x int) F) AoAoF {
+	return AoAoF(MapCells(Grid[int](m).checkZero("ToFloat", "AoAoI"), toMaybe(f)))
+}
+
+// ToBool applies a function that takes an int and returns a B, such as a
+// predicate, to each element.  If the AoAoI is invalid or if any function
+// returns an invalid B, ToBool returns an invalid AoAoB.  Note: unlike Map,
+// this is a deep conversion of individual elements of the 2-D slice.
+func (m AoAoI) ToBool(f func(x int) B) AoAoB {
+	return AoAoB(MapCells(Grid[int](m).checkZero("ToBool", "AoAoI"), toMaybe(f)))
+}
+
+// ToStrCtx is like ToStr, but the function also takes a context.  The
+// context is checked before each element; once it is done, ToStrCtx stops and
+// returns an invalid AoAoS with the context's error.
+func (m AoAoI) ToStrCtx(ctx context.Context, f func(ctx context.Context, x int) S) AoAoS {
+	return AoAoS(MapCellsCtx(ctx, Grid[int](m).checkZero("ToStrCtx", "AoAoI"), toMaybeCtx(f)))
+}
+
+// ToStrAll is like ToStr, but applies the function to every element even
+// after a failure.  If any function returns an invalid S, ToStrAll returns an
+// invalid AoAoS whose error joins the errors from all failing elements, each
+// tagged with its row and column.
+func (m AoAoI) ToStrAll(f func(x int) S) AoAoS {
+	return AoAoS(MapCellsAll(Grid[int](m).checkZero("ToStrAll", "AoAoI"), toMaybe(f)))
+}
 
-	return JustAoAoS(xss)
+// ParallelToStr is like ToStr, but runs the function on up to n elements
+// concurrently, with the same ordering and error semantics as ParallelMap.
+func (m AoAoI) ParallelToStr(n int, f func(x int) S) AoAoS {
+	return AoAoS(ParallelMapCells(Grid[int](m).checkZero("ParallelToStr", "AoAoI"), n, toMaybe(f)))
 }
 
 // Unbox returns the underlying 2-D slice of ints or error.
 func (m AoAoI) Unbox() ([][]int, error) {
 	if m.just == nil && m.err == nil {
-		return nil, errors.New("zero-value AoAoI")
+		return nil, &OpError{Op: "Unbox", Type: "AoAoI", Err: ErrZeroValue}
 	}
 	return m.just, m.err
 }
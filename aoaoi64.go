@@ -0,0 +1,162 @@
+package maybe
+
+import "fmt"
+
+// AoAoI64 implements the Maybe monad for a 2-D slice of int64s.  An AoAoI64 is
+// considered 'valid' or 'invalid' depending on whether it contains a 2-D
+// slice of int64s or an error value.  A zero-value AoAoI64 is invalid and
+// Unbox() will return an error to that effect.
+type AoAoI64 Grid[int64]
+
+// NewAoAoI64 constructs an AoAoI64 from a given 2-D slice of int64s or error.
+// If e is not nil, returns ErrAoAoI64(e), otherwise returns JustAoAoI64(x).
+func NewAoAoI64(x [][]int64, e error) AoAoI64 {
+	if e != nil {
+		return ErrAoAoI64(e)
+	}
+	return JustAoAoI64(x)
+}
+
+// JustAoAoI64 constructs a valid AoAoI64 from a given 2-D slice of int64s.
+func JustAoAoI64(x [][]int64) AoAoI64 {
+	return AoAoI64{just: x}
+}
+
+// ErrAoAoI64 constructs an invalid AoAoI64 from a given error.
+func ErrAoAoI64(e error) AoAoI64 {
+	return AoAoI64{err: e}
+}
+
+// NothingAoAoI64 constructs an invalid AoAoI64 that holds nothing, for a
+// missing rather than a failed value.  See ErrNothing.
+func NothingAoAoI64() AoAoI64 {
+	return AoAoI64{err: ErrNothing}
+}
+
+// IsErr returns true for an invalid AoAoI64.
+func (m AoAoI64) IsErr() bool {
+	return m.just == nil || m.err != nil
+}
+
+// Bind applies a function that takes a 2-D slice of int64s and returns an
+// AoAoI64.
+func (m AoAoI64) Bind(f func(x [][]int64) AoAoI64) AoAoI64 {
+	if m.IsErr() {
+		return m
+	}
+
+	return try(f, m.just, ErrAoAoI64)
+}
+
+// Join applies a function to each row of a valid AoAoI64 and returns an AoI64
+// of the results.  If the AoAoI64 is invalid or if any function returns an
+// invalid I64, Join returns an invalid AoI64.
+func (m AoAoI64) Join(f func(x []int64) I64) AoI64 {
+	return AoI64(JoinGrid(Grid[int64](m).checkZero("Join", "AoAoI64"), toMaybe(f)))
+}
+
+// Flatten joins a 2-D slice of int64s into a 1-D slice
+func (m AoAoI64) Flatten() AoI64 {
+	return AoI64(Grid[int64](m).checkZero("Flatten", "AoAoI64").Flatten())
+}
+
+// Map applies a function to each element of a valid AoAoI64 (i.e. a 1-D slice)
+// and returns a new AoAoI64.  If the AoAoI64 is invalid or if any function
+// returns an invalid AoI64, Map returns an invalid AoAoI64.
+func (m AoAoI64) Map(f func(x []int64) AoI64) AoAoI64 {
+	return AoAoI64(MapGrid(Grid[int64](m).checkZero("Map", "AoAoI64"), toSlice(f)))
+}
+
+// Filter returns an AoAoI64 of the rows of a valid AoAoI64 for which a function
+// returns true, in order.  If the AoAoI64 is invalid or if any function returns
+// an invalid B, Filter returns an invalid AoAoI64.
+func (m AoAoI64) Filter(f func(x []int64) B) AoAoI64 {
+	return AoAoI64(Grid[int64](m).checkZero("Filter", "AoAoI64").Filter(toMaybe(f)))
+}
+
+// Reject is the opposite of Filter: it returns an AoAoI64 of the rows of a
+// valid AoAoI64 for which a function returns false.
+func (m AoAoI64) Reject(f func(x []int64) B) AoAoI64 {
+	return AoAoI64(Grid[int64](m).checkZero("Reject", "AoAoI64").Reject(toMaybe(f)))
+}
+
+// Partition splits a valid AoAoI64 into the rows for which a function returns
+// true and those for which it returns false.  If the AoAoI64 is invalid or if
+// any function returns an invalid B, both results are invalid.
+func (m AoAoI64) Partition(f func(x []int64) B) (AoAoI64, AoAoI64) {
+	in, out := Grid[int64](m).checkZero("Partition", "AoAoI64").Partition(toMaybe(f))
+	return AoAoI64(in), AoAoI64(out)
+}
+
+// IsNothing returns true for an AoAoI64 that holds nothing, i.e. one whose
+// error is ErrNothing itself.  Such an AoAoI64 is also invalid, so IsErr
+// returns true.  An error that only wraps ErrNothing, such as an ElementError
+// for a missing element, is an ordinary error.
+func (m AoAoI64) IsNothing() bool {
+	return Grid[int64](m).IsNothing()
+}
+
+// JustOr returns a valid AoAoI64 holding a default value if the AoAoI64 holds
+// nothing, or otherwise returns the AoAoI64 unchanged.  Unlike OrElse, it
+// leaves other errors in place.  A nil default is replaced by an empty one, as
+// for OrElse.
+func (m AoAoI64) JustOr(x [][]int64) AoAoI64 {
+	return AoAoI64(Grid[int64](m).JustOr(x))
+}
+
+// OrElse returns the AoAoI64 if it is valid, or otherwise a valid AoAoI64
+// holding a default value.  A nil default is replaced by an empty one, so the
+// result is always valid.
+func (m AoAoI64) OrElse(x [][]int64) AoAoI64 {
+	return AoAoI64(Grid[int64](m).OrElse(x))
+}
+
+// Recover returns the AoAoI64 if it is valid, or otherwise a valid AoAoI64
+// holding the value returned by a function that takes the error, with nil
+// replaced by an empty value as for OrElse.
+func (m AoAoI64) Recover(f func(err error) [][]int64) AoAoI64 {
+	return AoAoI64(Grid[int64](m).checkZero("Unbox", "AoAoI64").Recover(f))
+}
+
+// MapErr returns the AoAoI64 if it is valid, or otherwise an invalid AoAoI64
+// with the error returned by a function that takes the original error, e.g. to
+// add context with fmt.Errorf and %w.  If the function returns nil, the
+// original error is kept.
+func (m AoAoI64) MapErr(f func(err error) error) AoAoI64 {
+	return AoAoI64(Grid[int64](m).checkZero("Unbox", "AoAoI64").MapErr(f))
+}
+
+// Catch is like Recover, but only for errors that match a target according to
+// errors.Is, and the function returns an AoAoI64, so it may fail again.  Valid
+// values and other errors are returned unchanged.
+func (m AoAoI64) Catch(target error, f func(err error) AoAoI64) AoAoI64 {
+	return AoAoI64(Grid[int64](m).checkZero("Unbox", "AoAoI64").Catch(target, toGrid(f)))
+}
+
+// MarshalJSON implements json.Marshaler using the wire format described in
+// the package documentation.
+func (m AoAoI64) MarshalJSON() ([]byte, error) {
+	return marshalJSON(m.Unbox())
+}
+
+// UnmarshalJSON implements json.Unmarshaler using the wire format described
+// in the package documentation.
+func (m *AoAoI64) UnmarshalJSON(b []byte) error {
+	return unmarshalJSON(b, (*Maybe[[][]int64])(m), "AoAoI64")
+}
+
+// String returns a string representation, mostly useful for debugging.
+func (m AoAoI64) String() string {
+	if m.IsErr() {
+		return fmt.Sprintf("Err %v", m.err)
+	}
+	return fmt.Sprintf("Just %v", m.just)
+}
+
+// Unbox returns the underlying 2-D slice of int64s or error.
+func (m AoAoI64) Unbox() ([][]int64, error) {
+	if m.just == nil && m.err == nil {
+		return nil, &OpError{Op: "Unbox", Type: "AoAoI64", Err: ErrZeroValue}
+	}
+	return m.just, m.err
+}
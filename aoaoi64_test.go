@@ -0,0 +1,41 @@
+package maybe_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/xdg/maybe"
+	"github.com/xdg/testy"
+)
+
+func TestAoAoI64(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	input := [][]int64{{1, 2}, {3}}
+	good := maybe.JustAoAoI64(input)
+	bad := maybe.ErrAoAoI64(errors.New("bad int64s"))
+
+	just, err := good.Unbox()
+	is.Equal(just, input)
+	is.Nil(err)
+	is.False(good.IsErr())
+
+	just, err = bad.Unbox()
+	is.Nil(just)
+	is.Equal(err.Error(), "bad int64s")
+	is.True(bad.IsErr())
+
+	is.Equal(maybe.NewAoAoI64(input, nil), good)
+	is.True(maybe.NewAoAoI64(nil, err).IsErr())
+	is.Equal(good.String(), "Just [[1 2] [3]]")
+
+	flat, err := good.Flatten().Unbox()
+	is.Equal(flat, []int64{1, 2, 3})
+	is.Nil(err)
+
+	zero := maybe.AoAoI64{}
+	is.True(zero.IsErr())
+	_, err = zero.Unbox()
+	is.NotNil(err)
+}
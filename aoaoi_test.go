@@ -208,3 +208,23 @@ func TestAoAoIToStr(t *testing.T) {
 	got = good.ToStr(func(x int) maybe.S { return maybe.ErrS(errors.New("invalid")) })
 	is.True(got.IsErr())
 }
+
+func TestAoAoIFold(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	max := func(acc, x int) maybe.I {
+		if x > acc {
+			return maybe.JustI(x)
+		}
+		return maybe.JustI(acc)
+	}
+	good := maybe.JustAoAoI([][]int{{3, 9, 1}, {7, 2, 8}})
+
+	is.Equal(good.FoldRows(0, max), maybe.JustAoI([]int{9, 8}))
+	is.Equal(good.FoldCols(0, max), maybe.JustAoI([]int{7, 9, 8}))
+
+	_, err := maybe.JustAoAoI([][]int{{1}, {2, 3}}).FoldCols(0, max).Unbox()
+	is.True(errors.Is(err, maybe.ErrRagged))
+	is.True(maybe.ErrAoAoI(errors.New("bad")).FoldRows(0, max).IsErr())
+}
@@ -0,0 +1,186 @@
+package maybe
+
+import "fmt"
+
+// AoAoR implements the Maybe monad for a 2-D slice of runes.  An AoAoR is
+// considered 'valid' or 'invalid' depending on whether it contains a 2-D
+// slice of runes or an error value.  A zero-value AoAoR is invalid and
+// Unbox() will return an error to that effect.
+type AoAoR Grid[rune]
+
+// NewAoAoR constructs an AoAoR from a given 2-D slice of runes or error.
+// If e is not nil, returns ErrAoAoR(e), otherwise returns JustAoAoR(x).
+func NewAoAoR(x [][]rune, e error) AoAoR {
+	if e != nil {
+		return ErrAoAoR(e)
+	}
+	return JustAoAoR(x)
+}
+
+// JustAoAoR constructs a valid AoAoR from a given 2-D slice of runes.
+func JustAoAoR(x [][]rune) AoAoR {
+	return AoAoR{just: x}
+}
+
+// ErrAoAoR constructs an invalid AoAoR from a given error.
+func ErrAoAoR(e error) AoAoR {
+	return AoAoR{err: e}
+}
+
+// NothingAoAoR constructs an invalid AoAoR that holds nothing, for a missing
+// rather than a failed value.  See ErrNothing.
+func NothingAoAoR() AoAoR {
+	return AoAoR{err: ErrNothing}
+}
+
+// IsErr returns true for an invalid AoAoR.
+func (m AoAoR) IsErr() bool {
+	return m.just == nil || m.err != nil
+}
+
+// Bind applies a function that takes a 2-D slice of runes and returns an
+// AoAoR.
+func (m AoAoR) Bind(f func(x [][]rune) AoAoR) AoAoR {
+	if m.IsErr() {
+		return m
+	}
+
+	return try(f, m.just, ErrAoAoR)
+}
+
+// Join applies a function to each row of a valid AoAoR and returns an AoR
+// of the results.  If the AoAoR is invalid or if any function returns an
+// invalid R, Join returns an invalid AoR.
+func (m AoAoR) Join(f func(x []rune) R) AoR {
+	return AoR(JoinGrid(Grid[rune](m).checkZero("Join", "AoAoR"), toMaybe(f)))
+}
+
+// Flatten joins a 2-D slice of runes into a 1-D slice
+func (m AoAoR) Flatten() AoR {
+	return AoR(Grid[rune](m).checkZero("Flatten", "AoAoR").Flatten())
+}
+
+// Map applies a function to each element of a valid AoAoR (i.e. a 1-D slice)
+// and returns a new AoAoR.  If the AoAoR is invalid or if any function
+// returns an invalid AoR, Map returns an invalid AoAoR.
+func (m AoAoR) Map(f func(x []rune) AoR) AoAoR {
+	return AoAoR(MapGrid(Grid[rune](m).checkZero("Map", "AoAoR"), toSlice(f)))
+}
+
+// Filter returns an AoAoR of the rows of a valid AoAoR for which a function
+// returns true, in order.  If the AoAoR is invalid or if any function returns
+// an invalid B, Filter returns an invalid AoAoR.
+func (m AoAoR) Filter(f func(x []rune) B) AoAoR {
+	return AoAoR(Grid[rune](m).checkZero("Filter", "AoAoR").Filter(toMaybe(f)))
+}
+
+// Reject is the opposite of Filter: it returns an AoAoR of the rows of a valid
+// AoAoR for which a function returns false.
+func (m AoAoR) Reject(f func(x []rune) B) AoAoR {
+	return AoAoR(Grid[rune](m).checkZero("Reject", "AoAoR").Reject(toMaybe(f)))
+}
+
+// Partition splits a valid AoAoR into the rows for which a function returns
+// true and those for which it returns false.  If the AoAoR is invalid or if any
+// function returns an invalid B, both results are invalid.
+func (m AoAoR) Partition(f func(x []rune) B) (AoAoR, AoAoR) {
+	in, out := Grid[rune](m).checkZero("Partition", "AoAoR").Partition(toMaybe(f))
+	return AoAoR(in), AoAoR(out)
+}
+
+// MapRunes applies a function to each individual rune of a valid AoAoR and
+// returns a new AoAoR of the same shape.  If the AoAoR is invalid or if any
+// function returns an invalid R, MapRunes returns an invalid AoAoR.
+func (m AoAoR) MapRunes(f func(x rune) R) AoAoR {
+	return AoAoR(MapCells(Grid[rune](m).checkZero("MapRunes", "AoAoR"), toMaybe(f)))
+}
+
+// JoinStr joins the runes of each row of a valid AoAoR into a string,
+// resulting in an AoS.  If the AoAoR is invalid, JoinStr returns an invalid
+// AoS.
+func (m AoAoR) JoinStr() AoS {
+	if m.IsErr() {
+		return ErrAoS(zeroErr(m.err, "JoinStr", "AoAoR"))
+	}
+
+	xs := make([]string, len(m.just))
+	for i, v := range m.just {
+		xs[i] = string(v)
+	}
+
+	return JustAoS(xs)
+}
+
+// IsNothing returns true for an AoAoR that holds nothing, i.e. one whose error
+// is ErrNothing itself.  Such an AoAoR is also invalid, so IsErr returns true.
+// An error that only wraps ErrNothing, such as an ElementError for a missing
+// element, is an ordinary error.
+func (m AoAoR) IsNothing() bool {
+	return Grid[rune](m).IsNothing()
+}
+
+// JustOr returns a valid AoAoR holding a default value if the AoAoR holds
+// nothing, or otherwise returns the AoAoR unchanged.  Unlike OrElse, it leaves
+// other errors in place.  A nil default is replaced by an empty one, as for
+// OrElse.
+func (m AoAoR) JustOr(x [][]rune) AoAoR {
+	return AoAoR(Grid[rune](m).JustOr(x))
+}
+
+// OrElse returns the AoAoR if it is valid, or otherwise a valid AoAoR holding a
+// default value.  A nil default is replaced by an empty one, so the result is
+// always valid.
+func (m AoAoR) OrElse(x [][]rune) AoAoR {
+	return AoAoR(Grid[rune](m).OrElse(x))
+}
+
+// Recover returns the AoAoR if it is valid, or otherwise a valid AoAoR holding
+// the value returned by a function that takes the error, with nil replaced by
+// an empty value as for OrElse.
+func (m AoAoR) Recover(f func(err error) [][]rune) AoAoR {
+	return AoAoR(Grid[rune](m).checkZero("Unbox", "AoAoR").Recover(f))
+}
+
+// MapErr returns the AoAoR if it is valid, or otherwise an invalid AoAoR with
+// the error returned by a function that takes the original error, e.g. to add
+// context with fmt.Errorf and %w.  If the function returns nil, the original
+// error is kept.
+func (m AoAoR) MapErr(f func(err error) error) AoAoR {
+	return AoAoR(Grid[rune](m).checkZero("Unbox", "AoAoR").MapErr(f))
+}
+
+// Catch is like Recover, but only for errors that match a target according
+// to errors.Is, and the function returns an AoAoR, so it may fail again.  Valid
+// values and other errors are returned unchanged.
+func (m AoAoR) Catch(target error, f func(err error) AoAoR) AoAoR {
+	return AoAoR(Grid[rune](m).checkZero("Unbox", "AoAoR").Catch(target, toGrid(f)))
+}
+
+// MarshalJSON implements json.Marshaler using the wire format described in
+// the package documentation.
+func (m AoAoR) MarshalJSON() ([]byte, error) {
+	return marshalJSON(m.Unbox())
+}
+
+// UnmarshalJSON implements json.Unmarshaler using the wire format described
+// in the package documentation.
+func (m *AoAoR) UnmarshalJSON(b []byte) error {
+	return unmarshalJSON(b, (*Maybe[[][]rune])(m), "AoAoR")
+}
+
+// String returns a string representation, mostly useful for debugging.
+// Runes are shown as quoted characters rather than numbers.
+func (m AoAoR) String() string {
+	if m.IsErr() {
+		return fmt.Sprintf("Err %v", m.err)
+	}
+	return fmt.Sprintf("Just %q", m.just)
+}
+
+// Unbox returns the underlying 2-D slice of runes or error.
+func (m AoAoR) Unbox() ([][]rune, error) {
+	if m.just == nil && m.err == nil {
+		return nil, &OpError{Op: "Unbox", Type: "AoAoR", Err: ErrZeroValue}
+	}
+	return m.just, m.err
+}
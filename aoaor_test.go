@@ -0,0 +1,76 @@
+package maybe_test
+
+import (
+	"errors"
+	"testing"
+	"unicode"
+
+	"github.com/xdg/maybe"
+	"github.com/xdg/testy"
+)
+
+func TestAoAoR(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	input := [][]rune{[]rune("ab"), []rune("ç")}
+	good := maybe.JustAoAoR(input)
+	bad := maybe.ErrAoAoR(errors.New("bad runes"))
+	var just [][]rune
+	var err error
+
+	just, err = good.Unbox()
+	is.Equal(just, input)
+	is.Nil(err)
+	is.False(good.IsErr())
+
+	just, err = bad.Unbox()
+	is.Nil(just)
+	is.Equal(err.Error(), "bad runes")
+	is.True(bad.IsErr())
+
+	is.Equal(maybe.NewAoAoR(input, nil), good)
+	is.True(maybe.NewAoAoR(nil, err).IsErr())
+
+	is.Equal(good.String(), "Just [['a' 'b'] ['ç']]")
+	is.Equal(bad.String(), "Err bad runes")
+
+	zero := maybe.AoAoR{}
+	is.True(zero.IsErr())
+	_, err = zero.Unbox()
+	is.NotNil(err)
+
+	flat, err := good.Flatten().Unbox()
+	is.Equal(flat, []rune("abç"))
+	is.Nil(err)
+}
+
+func TestAoAoRText(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	upper := func(x rune) maybe.R { return maybe.JustR(unicode.ToUpper(x)) }
+	noDigits := func(x rune) maybe.R {
+		if unicode.IsDigit(x) {
+			return maybe.ErrR(errors.New("digit"))
+		}
+		return maybe.JustR(x)
+	}
+
+	lines := maybe.JustAoS([]string{"straße", "", "ünï"})
+	grid := lines.SplitRunes()
+	xss, err := grid.Unbox()
+	is.Equal(xss, [][]rune{[]rune("straße"), {}, []rune("ünï")})
+	is.Nil(err)
+
+	xs, err := grid.MapRunes(upper).JoinStr().Unbox()
+	is.Equal(xs, []string{"STRAßE", "", "ÜNÏ"})
+	is.Nil(err)
+
+	_, err = maybe.JustAoS([]string{"ab", "c4"}).SplitRunes().MapRunes(noDigits).Unbox()
+	is.Equal(err.Error(), "element [1 1]: digit")
+
+	is.True(maybe.ErrAoS(errors.New("bad strings")).SplitRunes().IsErr())
+	is.True(maybe.ErrAoAoR(errors.New("bad runes")).MapRunes(upper).IsErr())
+	is.True(maybe.ErrAoAoR(errors.New("bad runes")).JoinStr().IsErr())
+}
@@ -1,18 +1,16 @@
 package maybe
 
 import (
-	"errors"
+	"context"
 	"fmt"
+	"time"
 )
 
 // AoAoS implements the Maybe monad for a 2-D slice of strings.  An AoAoS is
 // considered 'valid' or 'invalid' depending on whether it contains a 2-D
 // slice of strings or an error value.  A zero-value AoAoS is invalid and
 // Unbox() will return an error to that effect.
-type AoAoS struct {
-	just [][]string
-	err  error
-}
+type AoAoS Grid[string]
 
 // NewAoAoS constructs an AoAoS from a given 2-D slice of strings or error. If
 // e is not nil, returns ErrAoAoS(e), otherwise returns JustAoAoS(s)
@@ -33,6 +31,19 @@ func ErrAoAoS(e error) AoAoS {
 	return AoAoS{err: e}
 }
 
+// NothingAoAoS constructs an invalid AoAoS that holds nothing, for a missing
+// rather than a failed value.  See ErrNothing.
+func NothingAoAoS() AoAoS {
+	return AoAoS{err: ErrNothing}
+}
+
+// SequenceAoS turns a slice of AoS into an AoAoS with a row for each one.  If
+// any element is invalid, SequenceAoS returns an invalid AoAoS with the error
+// of the first one, tagged with its index.
+func SequenceAoS(xs []AoS) AoAoS {
+	return AoAoS(Sequence(xs))
+}
+
 // IsErr returns true for an invalid AoAoS.
 func (m AoAoS) IsErr() bool {
 	return m.just == nil || m.err != nil
@@ -44,59 +55,122 @@ func (m AoAoS) Bind(f func(s [][]string) AoAoS) AoAoS {
 		return m
 	}
 
-	return f(m.just)
+	return try(f, m.just, ErrAoAoS)
 }
 
-// Join applies a function that takes a 2-D slice of strings and returns an AoS.
-func (m AoAoS) Join(f func(s []string) S) AoS {
+// BindCtx is like Bind, but the function also takes a context.  If the
+// context is done, BindCtx returns an invalid AoAoS with the context's error
+// instead of calling the function.
+func (m AoAoS) BindCtx(ctx context.Context, f func(ctx context.Context, s [][]string) AoAoS) AoAoS {
 	if m.IsErr() {
-		return ErrAoS(m.err)
+		return m
 	}
-
-	xss := make([]string, len(m.just))
-	for i, v := range m.just {
-		s, err := f(v).Unbox()
-		if err != nil {
-			return ErrAoS(err)
-		}
-		xss[i] = s
+	if err := ctx.Err(); err != nil {
+		return ErrAoAoS(err)
 	}
 
-	return JustAoS(xss)
+	return tryCtx(ctx, f, m.just, ErrAoAoS)
 }
 
-// Flatten joins a 2-D slice of strings into a 1-D slice
-func (m AoAoS) Flatten() AoS {
-	if m.IsErr() {
-		return ErrAoS(m.err)
-	}
+// Join applies a function that takes a 2-D slice of strings and returns an AoS.
+func (m AoAoS) Join(f func(s []string) S) AoS {
+	return AoS(JoinGrid(Grid[string](m).checkZero("Join", "AoAoS"), toMaybe(f)))
+}
 
-	xs := make([]string, 0)
-	for _, v := range m.just {
-		xs = append(xs, v...)
-	}
+// JoinCtx is like Join, but the function also takes a context.  The context
+// is checked before each row; once it is done, JoinCtx stops and returns an
+// invalid AoS with the context's error.
+func (m AoAoS) JoinCtx(ctx context.Context, f func(ctx context.Context, s []string) S) AoS {
+	return AoS(JoinGridCtx(ctx, Grid[string](m).checkZero("JoinCtx", "AoAoS"), toMaybeCtx(f)))
+}
 
-	return JustAoS(xs)
+// JoinAll is like Join, but applies the function to every row even after a
+// failure.  If any function returns an invalid S, JoinAll returns an
+// invalid AoS whose error joins the errors from all failing rows, each tagged
+// with its row index.
+func (m AoAoS) JoinAll(f func(s []string) S) AoS {
+	return AoS(JoinGridAll(Grid[string](m).checkZero("JoinAll", "AoAoS"), toMaybe(f)))
+}
+
+// Flatten joins a 2-D slice of strings into a 1-D slice
+func (m AoAoS) Flatten() AoS {
+	return AoS(Grid[string](m).checkZero("Flatten", "AoAoS").Flatten())
 }
 
 // Map applies a function to each element of a valid AoAoS (i.e. a 1-D slice)
 // and returns a new AoAoS.  If the AoAoS is invalid or if any function
 // returns an invalid AoS, Map returns an invalid AoAoS.
 func (m AoAoS) Map(f func(xs []string) AoS) AoAoS {
-	if m.IsErr() {
-		return m
-	}
+	return AoAoS(MapGrid(Grid[string](m).checkZero("Map", "AoAoS"), toSlice(f)))
+}
 
-	xss := make([][]string, len(m.just))
-	for i, v := range m.just {
-		strs, err := f(v).Unbox()
-		if err != nil {
-			return ErrAoAoS(err)
-		}
-		xss[i] = strs
-	}
+// Filter returns an AoAoS of the rows of a valid AoAoS for which a function
+// returns true, in order.  If the AoAoS is invalid or if any function returns
+// an invalid B, Filter returns an invalid AoAoS.
+func (m AoAoS) Filter(f func(xs []string) B) AoAoS {
+	return AoAoS(Grid[string](m).checkZero("Filter", "AoAoS").Filter(toMaybe(f)))
+}
+
+// Reject is the opposite of Filter: it returns an AoAoS of the rows of a valid
+// AoAoS for which a function returns false.
+func (m AoAoS) Reject(f func(xs []string) B) AoAoS {
+	return AoAoS(Grid[string](m).checkZero("Reject", "AoAoS").Reject(toMaybe(f)))
+}
 
-	return JustAoAoS(xss)
+// Partition splits a valid AoAoS into the rows for which a function returns
+// true and those for which it returns false.  If the AoAoS is invalid or if any
+// function returns an invalid B, both results are invalid.
+func (m AoAoS) Partition(f func(xs []string) B) (AoAoS, AoAoS) {
+	in, out := Grid[string](m).checkZero("Partition", "AoAoS").Partition(toMaybe(f))
+	return AoAoS(in), AoAoS(out)
+}
+
+// FoldRows folds each row of a valid AoAoS into a single value, as AoS.Fold
+// does, resulting in an AoS with one value per row.  If the AoAoS is invalid or
+// if any function returns an invalid S, FoldRows returns an invalid AoS.
+func (m AoAoS) FoldRows(init string, f func(acc string, x string) S) AoS {
+	return AoS(FoldGridRows(Grid[string](m).checkZero("FoldRows", "AoAoS"), init, toMaybe2(f)))
+}
+
+// FoldCols folds each column of a valid AoAoS into a single value, going down
+// the rows, resulting in an AoS with one value per column.  All rows must
+// have the same length; otherwise, FoldCols returns an invalid AoS with an
+// error wrapping ErrRagged.  If the AoAoS is invalid or if any function returns
+// an invalid S, FoldCols returns an invalid AoS.
+func (m AoAoS) FoldCols(init string, f func(acc string, x string) S) AoS {
+	return AoS(FoldGridCols(Grid[string](m).checkZero("FoldCols", "AoAoS"), init, toMaybe2(f)))
+}
+
+// Unzip is the opposite of AoS.Zip: it splits a valid AoAoS whose rows each
+// hold exactly two elements into an AoS of the first elements and an AoS of the
+// second elements.  If the AoAoS is invalid or any row has a different
+// length, both results are invalid.
+func (m AoAoS) Unzip() (AoS, AoS) {
+	xs, ys := Grid[string](m).checkZero("Unzip", "AoAoS").Unzip()
+	return AoS(xs), AoS(ys)
+}
+
+// MapCtx is like Map, but the function also takes a context.  The context is
+// checked before each row; once it is done, MapCtx stops and returns an
+// invalid AoAoS with the context's error.
+func (m AoAoS) MapCtx(ctx context.Context, f func(ctx context.Context, xs []string) AoS) AoAoS {
+	return AoAoS(MapGridCtx(ctx, Grid[string](m).checkZero("MapCtx", "AoAoS"), toSliceCtx(f)))
+}
+
+// MapAll is like Map, but applies the function to every row even after a
+// failure.  If any function returns an invalid AoS, MapAll returns an
+// invalid AoAoS whose error joins the errors from all failing rows, each
+// tagged with its row index.
+func (m AoAoS) MapAll(f func(xs []string) AoS) AoAoS {
+	return AoAoS(MapGridAll(Grid[string](m).checkZero("MapAll", "AoAoS"), toSlice(f)))
+}
+
+// ParallelMap is like Map, but runs the function on up to n rows
+// concurrently, or GOMAXPROCS rows if n is less than 1.  The order of rows is
+// preserved.  Once a function returns an invalid AoS, no further rows are
+// started and ParallelMap returns an invalid AoAoS.
+func (m AoAoS) ParallelMap(n int, f func(xs []string) AoS) AoAoS {
+	return AoAoS(ParallelMapGrid(Grid[string](m).checkZero("ParallelMap", "AoAoS"), n, toSlice(f)))
 }
 
 // ToInt applies a function that takes a string and returns an I.  If the
@@ -104,23 +178,136 @@ func (m AoAoS) Map(f func(xs []string) AoS) AoAoS {
 // invalid AoAoI.  Note: unlike Map, this is a deep conversion of individual
 // elements of the 2-D slice of strings.
 func (m AoAoS) ToInt(f func(s string) I) AoAoI {
-	if m.IsErr() {
-		return ErrAoAoI(m.err)
-	}
+	return AoAoI(MapCells(Grid[string](m).checkZero("ToInt", "AoAoS"), toMaybe(f)))
+}
 
-	xss := make([][]int, len(m.just))
-	for i, xs := range m.just {
-		xss[i] = make([]int, len(xs))
-		for j, v := range xs {
-			num, err := f(v).Unbox()
-			if err != nil {
-				return ErrAoAoI(err)
-			}
-			xss[i][j] = num
-		}
-	}
+// ToFloat applies a function that takes a string and returns an F.  If the
+// AoAoS is invalid or if any function returns an invalid F, ToFloat returns
+// an invalid AoAoF.  Note: unlike Map, this is a deep conversion of
+// individual elements of the 2-D slice.
+func (m AoAoS) ToFloat(f func(s string) F) AoAoF {
+	return AoAoF(MapCells(Grid[string](m).checkZero("ToFloat", "AoAoS"), toMaybe(f)))
+}
+
+// ToBool applies a function that takes a string and returns a B, such as a
+// predicate, to each element.  If the AoAoS is invalid or if any function
+// returns an invalid B, ToBool returns an invalid AoAoB.  Note: unlike Map,
+// this is a deep conversion of individual elements of the 2-D slice.
+func (m AoAoS) ToBool(f func(s string) B) AoAoB {
+	return AoAoB(MapCells(Grid[string](m).checkZero("ToBool", "AoAoS"), toMaybe(f)))
+}
+
+// ToTime parses each individual element of a valid AoAoS as a time according
+// to a layout, resulting in an AoAoT.  If the AoAoS is invalid or any element
+// can't be parsed, ToTime returns an invalid AoAoT.
+func (m AoAoS) ToTime(layout string) AoAoT {
+	return AoAoT(MapCells(Grid[string](m).checkZero("ToTime", "AoAoS"), func(s string) Maybe[time.Time] {
+		return New(time.Parse(layout, s))
+	}))
+}
+
+// ToDuration parses each individual element of a valid AoAoS as a duration,
+// resulting in an AoAoD.  If the AoAoS is invalid or any element can't be
+// parsed, ToDuration returns an invalid AoAoD.
+func (m AoAoS) ToDuration() AoAoD {
+	return AoAoD(MapCells(Grid[string](m).checkZero("ToDuration", "AoAoS"), func(s string) Maybe[time.Duration] {
+		return New(time.ParseDuration(s))
+	}))
+}
+
+// ToMoS converts a valid AoAoS of key/value pairs, e.g. split from
+// "key=value" lines, to a MoS.  Each row must have exactly two elements.  If
+// unique is true, a repeated key makes the result invalid with an error
+// wrapping ErrDuplicateKey; otherwise, later rows win.  If the AoAoS is
+// invalid or any row fails, ToMoS returns an invalid MoS with an
+// ElementError for the row.
+func (m AoAoS) ToMoS(unique bool) MoS {
+	return MoS(GridToDict(Grid[string](m).checkZero("ToMoS", "AoAoS"), Just[string], Just[string], unique))
+}
+
+// ToMoI is like ToMoS, but applies a function that takes a string and
+// returns an I to the value of each pair, resulting in a MoI.  If any
+// function returns an invalid I, ToMoI returns an invalid MoI.
+func (m AoAoS) ToMoI(f func(s string) I, unique bool) MoI {
+	return MoI(GridToDict(Grid[string](m).checkZero("ToMoI", "AoAoS"), Just[string], toMaybe(f), unique))
+}
+
+// ToIntCtx is like ToInt, but the function also takes a context.  The
+// context is checked before each element; once it is done, ToIntCtx stops and
+// returns an invalid AoAoI with the context's error.
+func (m AoAoS) ToIntCtx(ctx context.Context, f func(ctx context.Context, s string) I) AoAoI {
+	return AoAoI(MapCellsCtx(ctx, Grid[string](m).checkZero("ToIntCtx", "AoAoS"), toMaybeCtx(f)))
+}
+
+// ToIntAll is like ToInt, but applies the function to every element even
+// after a failure.  If any function returns an invalid I, ToIntAll returns an
+// invalid AoAoI whose error joins the errors from all failing elements, each
+// tagged with its row and column.
+func (m AoAoS) ToIntAll(f func(s string) I) AoAoI {
+	return AoAoI(MapCellsAll(Grid[string](m).checkZero("ToIntAll", "AoAoS"), toMaybe(f)))
+}
+
+// ParallelToInt is like ToInt, but runs the function on up to n elements
+// concurrently, with the same ordering and error semantics as ParallelMap.
+func (m AoAoS) ParallelToInt(n int, f func(s string) I) AoAoI {
+	return AoAoI(ParallelMapCells(Grid[string](m).checkZero("ParallelToInt", "AoAoS"), n, toMaybe(f)))
+}
+
+// IsNothing returns true for an AoAoS that holds nothing, i.e. one whose error
+// is ErrNothing itself.  Such an AoAoS is also invalid, so IsErr returns true.
+// An error that only wraps ErrNothing, such as an ElementError for a missing
+// element, is an ordinary error.
+func (m AoAoS) IsNothing() bool {
+	return Grid[string](m).IsNothing()
+}
+
+// JustOr returns a valid AoAoS holding a default value if the AoAoS holds
+// nothing, or otherwise returns the AoAoS unchanged.  Unlike OrElse, it leaves
+// other errors in place.  A nil default is replaced by an empty one, as for
+// OrElse.
+func (m AoAoS) JustOr(x [][]string) AoAoS {
+	return AoAoS(Grid[string](m).JustOr(x))
+}
+
+// OrElse returns the AoAoS if it is valid, or otherwise a valid AoAoS holding a
+// default value.  A nil default is replaced by an empty one, so the result is
+// always valid.
+func (m AoAoS) OrElse(x [][]string) AoAoS {
+	return AoAoS(Grid[string](m).OrElse(x))
+}
+
+// Recover returns the AoAoS if it is valid, or otherwise a valid AoAoS holding
+// the value returned by a function that takes the error, with nil replaced by
+// an empty value as for OrElse.
+func (m AoAoS) Recover(f func(err error) [][]string) AoAoS {
+	return AoAoS(Grid[string](m).checkZero("Unbox", "AoAoS").Recover(f))
+}
+
+// MapErr returns the AoAoS if it is valid, or otherwise an invalid AoAoS with
+// the error returned by a function that takes the original error, e.g. to add
+// context with fmt.Errorf and %w.  If the function returns nil, the original
+// error is kept.
+func (m AoAoS) MapErr(f func(err error) error) AoAoS {
+	return AoAoS(Grid[string](m).checkZero("Unbox", "AoAoS").MapErr(f))
+}
+
+// Catch is like Recover, but only for errors that match a target according
+// to errors.Is, and the function returns an AoAoS, so it may fail again.  Valid
+// values and other errors are returned unchanged.
+func (m AoAoS) Catch(target error, f func(err error) AoAoS) AoAoS {
+	return AoAoS(Grid[string](m).checkZero("Unbox", "AoAoS").Catch(target, toGrid(f)))
+}
+
+// MarshalJSON implements json.Marshaler using the wire format described in
+// the package documentation.
+func (m AoAoS) MarshalJSON() ([]byte, error) {
+	return marshalJSON(m.Unbox())
+}
 
-	return JustAoAoI(xss)
+// UnmarshalJSON implements json.Unmarshaler using the wire format described
+// in the package documentation.
+func (m *AoAoS) UnmarshalJSON(b []byte) error {
+	return unmarshalJSON(b, (*Maybe[[][]string])(m), "AoAoS")
 }
 
 // String returns a string representation, mostly useful for debugging.
@@ -134,7 +321,7 @@ func (m AoAoS) String() string {
 // Unbox returns the underlying 2-D slice of strings value or error.
 func (m AoAoS) Unbox() ([][]string, error) {
 	if m.just == nil && m.err == nil {
-		return nil, errors.New("zero-value AoAoS")
+		return nil, &OpError{Op: "Unbox", Type: "AoAoS", Err: ErrZeroValue}
 	}
 	return m.just, m.err
 }
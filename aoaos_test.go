@@ -208,3 +208,82 @@ func TestAoAoSToInt(t *testing.T) {
 	got = bad.ToInt(f)
 	is.True(got.IsErr())
 }
+
+func TestAoAoSToIntAll(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	input := [][]string{
+		[]string{"23", "x"},
+		[]string{"y", "13"},
+	}
+	good := maybe.JustAoAoS(input)
+	var err error
+
+	f := func(s string) maybe.I { return maybe.NewI(strconv.Atoi(s)) }
+
+	_, err = good.ToIntAll(f).Unbox()
+	is.Equal(err.Error(), "element [0 1]: strconv.Atoi: parsing \"x\": invalid syntax\n"+
+		"element [1 0]: strconv.Atoi: parsing \"y\": invalid syntax")
+
+	// Row-wise variants tag errors with the row
+	rowErr := func(xs []string) maybe.S { return maybe.ErrS(errors.New("bad row")) }
+	_, err = good.JoinAll(rowErr).Unbox()
+	is.Equal(err.Error(), "element [0]: bad row\nelement [1]: bad row")
+}
+
+func TestAoAoSFilter(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	comment := func(xs []string) maybe.B {
+		return maybe.JustB(len(xs) > 0 && strings.HasPrefix(xs[0], "#"))
+	}
+	rows := maybe.JustAoAoS([][]string{{"# name", "age"}, {"ann", "32"}, {"#bob", "41"}})
+
+	xss, err := rows.Reject(comment).Unbox()
+	is.Equal(xss, [][]string{{"ann", "32"}})
+	is.Nil(err)
+
+	comments, data := rows.Partition(comment)
+	xss, err = comments.Unbox()
+	is.Equal(xss, [][]string{{"# name", "age"}, {"#bob", "41"}})
+	is.Nil(err)
+	is.Equal(data, maybe.JustAoAoS([][]string{{"ann", "32"}}))
+
+	is.Equal(rows.Filter(comment), comments)
+	is.True(maybe.ErrAoAoS(errors.New("bad strings")).Filter(comment).IsErr())
+}
+
+func TestAoAoSFold(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	longest := func(acc, x string) maybe.S {
+		if len(x) > len(acc) {
+			return maybe.JustS(x)
+		}
+		return maybe.JustS(acc)
+	}
+	good := maybe.JustAoAoS([][]string{{"id", "name"}, {"1234", "Al"}})
+
+	is.Equal(good.FoldRows("", longest), maybe.JustAoS([]string{"name", "1234"}))
+	is.Equal(good.FoldCols("", longest), maybe.JustAoS([]string{"1234", "name"}))
+
+	_, err := maybe.JustAoAoS([][]string{{"a", "b"}, {"c"}}).FoldCols("", longest).Unbox()
+	is.True(errors.Is(err, maybe.ErrRagged))
+	is.True(maybe.ErrAoAoS(errors.New("bad")).FoldCols("", longest).IsErr())
+}
+
+func TestSequenceAoS(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	rows := []maybe.AoS{maybe.JustAoS([]string{"a", "b"}), maybe.JustAoS([]string{"c"})}
+	is.Equal(maybe.SequenceAoS(rows), maybe.JustAoAoS([][]string{{"a", "b"}, {"c"}}))
+
+	_, err := maybe.SequenceAoS([]maybe.AoS{rows[0], maybe.ErrAoS(errors.New("bad row"))}).Unbox()
+	is.Equal(err.Error(), "element [1]: bad row")
+	_, err = maybe.SequenceAoS([]maybe.AoS{{}}).Unbox()
+	is.True(errors.Is(err, maybe.ErrZeroValue))
+}
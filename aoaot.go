@@ -0,0 +1,197 @@
+package maybe
+
+import (
+	"fmt"
+	"time"
+)
+
+// AoAoT implements the Maybe monad for a 2-D slice of times.  An AoAoT is
+// considered 'valid' or 'invalid' depending on whether it contains a 2-D
+// slice of times or an error value.  A zero-value AoAoT is invalid and
+// Unbox() will return an error to that effect.
+type AoAoT Grid[time.Time]
+
+// NewAoAoT constructs an AoAoT from a given 2-D slice of times or error.
+// If e is not nil, returns ErrAoAoT(e), otherwise returns JustAoAoT(x).
+func NewAoAoT(x [][]time.Time, e error) AoAoT {
+	if e != nil {
+		return ErrAoAoT(e)
+	}
+	return JustAoAoT(x)
+}
+
+// JustAoAoT constructs a valid AoAoT from a given 2-D slice of times.
+func JustAoAoT(x [][]time.Time) AoAoT {
+	return AoAoT{just: x}
+}
+
+// ErrAoAoT constructs an invalid AoAoT from a given error.
+func ErrAoAoT(e error) AoAoT {
+	return AoAoT{err: e}
+}
+
+// NothingAoAoT constructs an invalid AoAoT that holds nothing, for a missing
+// rather than a failed value.  See ErrNothing.
+func NothingAoAoT() AoAoT {
+	return AoAoT{err: ErrNothing}
+}
+
+// IsErr returns true for an invalid AoAoT.
+func (m AoAoT) IsErr() bool {
+	return m.just == nil || m.err != nil
+}
+
+// Bind applies a function that takes a 2-D slice of times and returns an
+// AoAoT.
+func (m AoAoT) Bind(f func(x [][]time.Time) AoAoT) AoAoT {
+	if m.IsErr() {
+		return m
+	}
+
+	return try(f, m.just, ErrAoAoT)
+}
+
+// Join applies a function to each row of a valid AoAoT and returns an AoT
+// of the results.  If the AoAoT is invalid or if any function returns an
+// invalid T, Join returns an invalid AoT.
+func (m AoAoT) Join(f func(x []time.Time) T) AoT {
+	return AoT(JoinGrid(Grid[time.Time](m).checkZero("Join", "AoAoT"), toMaybe(f)))
+}
+
+// Flatten joins a 2-D slice of times into a 1-D slice
+func (m AoAoT) Flatten() AoT {
+	return AoT(Grid[time.Time](m).checkZero("Flatten", "AoAoT").Flatten())
+}
+
+// Map applies a function to each element of a valid AoAoT (i.e. a 1-D slice)
+// and returns a new AoAoT.  If the AoAoT is invalid or if any function
+// returns an invalid AoT, Map returns an invalid AoAoT.
+func (m AoAoT) Map(f func(x []time.Time) AoT) AoAoT {
+	return AoAoT(MapGrid(Grid[time.Time](m).checkZero("Map", "AoAoT"), toSlice(f)))
+}
+
+// Filter returns an AoAoT of the rows of a valid AoAoT for which a function
+// returns true, in order.  If the AoAoT is invalid or if any function returns
+// an invalid B, Filter returns an invalid AoAoT.
+func (m AoAoT) Filter(f func(x []time.Time) B) AoAoT {
+	return AoAoT(Grid[time.Time](m).checkZero("Filter", "AoAoT").Filter(toMaybe(f)))
+}
+
+// Reject is the opposite of Filter: it returns an AoAoT of the rows of a valid
+// AoAoT for which a function returns false.
+func (m AoAoT) Reject(f func(x []time.Time) B) AoAoT {
+	return AoAoT(Grid[time.Time](m).checkZero("Reject", "AoAoT").Reject(toMaybe(f)))
+}
+
+// Partition splits a valid AoAoT into the rows for which a function returns
+// true and those for which it returns false.  If the AoAoT is invalid or if any
+// function returns an invalid B, both results are invalid.
+func (m AoAoT) Partition(f func(x []time.Time) B) (AoAoT, AoAoT) {
+	in, out := Grid[time.Time](m).checkZero("Partition", "AoAoT").Partition(toMaybe(f))
+	return AoAoT(in), AoAoT(out)
+}
+
+// Format formats each individual element of a valid AoAoT according to a
+// layout, resulting in an AoAoS.  If the AoAoT is invalid, Format returns an
+// invalid AoAoS.
+func (m AoAoT) Format(layout string) AoAoS {
+	return AoAoS(MapCells(Grid[time.Time](m).checkZero("Format", "AoAoT"), func(x time.Time) Maybe[string] {
+		return Just(x.Format(layout))
+	}))
+}
+
+// Earliest returns an AoT with the earliest time in each row of a valid
+// AoAoT.  If the AoAoT is invalid or any row is empty, Earliest returns an
+// invalid AoT.
+func (m AoAoT) Earliest() AoT {
+	return m.Join(func(xs []time.Time) T { return JustAoT(xs).Earliest() })
+}
+
+// Latest returns an AoT with the latest time in each row of a valid AoAoT.
+// If the AoAoT is invalid or any row is empty, Latest returns an invalid
+// AoT.
+func (m AoAoT) Latest() AoT {
+	return m.Join(func(xs []time.Time) T { return JustAoT(xs).Latest() })
+}
+
+// Span returns an AoD with the duration between the earliest and latest
+// times in each row of a valid AoAoT.  If the AoAoT is invalid, or any row is
+// empty or spans too long for a time.Duration, Span returns an invalid AoD.
+func (m AoAoT) Span() AoD {
+	return AoD(JoinGrid(Grid[time.Time](m).checkZero("Span", "AoAoT"), func(xs []time.Time) Maybe[time.Duration] {
+		return Maybe[time.Duration](JustAoT(xs).Span())
+	}))
+}
+
+// IsNothing returns true for an AoAoT that holds nothing, i.e. one whose error
+// is ErrNothing itself.  Such an AoAoT is also invalid, so IsErr returns true.
+// An error that only wraps ErrNothing, such as an ElementError for a missing
+// element, is an ordinary error.
+func (m AoAoT) IsNothing() bool {
+	return Grid[time.Time](m).IsNothing()
+}
+
+// JustOr returns a valid AoAoT holding a default value if the AoAoT holds
+// nothing, or otherwise returns the AoAoT unchanged.  Unlike OrElse, it leaves
+// other errors in place.  A nil default is replaced by an empty one, as for
+// OrElse.
+func (m AoAoT) JustOr(x [][]time.Time) AoAoT {
+	return AoAoT(Grid[time.Time](m).JustOr(x))
+}
+
+// OrElse returns the AoAoT if it is valid, or otherwise a valid AoAoT holding a
+// default value.  A nil default is replaced by an empty one, so the result is
+// always valid.
+func (m AoAoT) OrElse(x [][]time.Time) AoAoT {
+	return AoAoT(Grid[time.Time](m).OrElse(x))
+}
+
+// Recover returns the AoAoT if it is valid, or otherwise a valid AoAoT holding
+// the value returned by a function that takes the error, with nil replaced by
+// an empty value as for OrElse.
+func (m AoAoT) Recover(f func(err error) [][]time.Time) AoAoT {
+	return AoAoT(Grid[time.Time](m).checkZero("Unbox", "AoAoT").Recover(f))
+}
+
+// MapErr returns the AoAoT if it is valid, or otherwise an invalid AoAoT with
+// the error returned by a function that takes the original error, e.g. to add
+// context with fmt.Errorf and %w.  If the function returns nil, the original
+// error is kept.
+func (m AoAoT) MapErr(f func(err error) error) AoAoT {
+	return AoAoT(Grid[time.Time](m).checkZero("Unbox", "AoAoT").MapErr(f))
+}
+
+// Catch is like Recover, but only for errors that match a target according
+// to errors.Is, and the function returns an AoAoT, so it may fail again.  Valid
+// values and other errors are returned unchanged.
+func (m AoAoT) Catch(target error, f func(err error) AoAoT) AoAoT {
+	return AoAoT(Grid[time.Time](m).checkZero("Unbox", "AoAoT").Catch(target, toGrid(f)))
+}
+
+// MarshalJSON implements json.Marshaler using the wire format described in
+// the package documentation.
+func (m AoAoT) MarshalJSON() ([]byte, error) {
+	return marshalJSON(m.Unbox())
+}
+
+// UnmarshalJSON implements json.Unmarshaler using the wire format described
+// in the package documentation.
+func (m *AoAoT) UnmarshalJSON(b []byte) error {
+	return unmarshalJSON(b, (*Maybe[[][]time.Time])(m), "AoAoT")
+}
+
+// String returns a string representation, mostly useful for debugging.
+func (m AoAoT) String() string {
+	if m.IsErr() {
+		return fmt.Sprintf("Err %v", m.err)
+	}
+	return fmt.Sprintf("Just %v", m.just)
+}
+
+// Unbox returns the underlying 2-D slice of times or error.
+func (m AoAoT) Unbox() ([][]time.Time, error) {
+	if m.just == nil && m.err == nil {
+		return nil, &OpError{Op: "Unbox", Type: "AoAoT", Err: ErrZeroValue}
+	}
+	return m.just, m.err
+}
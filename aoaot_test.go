@@ -0,0 +1,53 @@
+package maybe_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/xdg/maybe"
+	"github.com/xdg/testy"
+)
+
+func TestAoAoT(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	rows := maybe.JustAoAoS([][]string{
+		{"2024-03-01", "2024-02-27", "2024-03-04"},
+		{"2024-01-10"},
+	})
+	good := rows.ToTime(time.DateOnly)
+	is.False(good.IsErr())
+
+	xss, err := good.Format("Jan 2").Unbox()
+	is.Equal(xss, [][]string{{"Mar 1", "Feb 27", "Mar 4"}, {"Jan 10"}})
+	is.Nil(err)
+
+	xs, err := good.Earliest().Format(time.DateOnly).Unbox()
+	is.Equal(xs, []string{"2024-02-27", "2024-01-10"})
+	is.Nil(err)
+
+	xs, err = good.Latest().Format(time.DateOnly).Unbox()
+	is.Equal(xs, []string{"2024-03-04", "2024-01-10"})
+	is.Nil(err)
+
+	spans, err := good.Span().Unbox()
+	is.Equal(spans, []time.Duration{6 * 24 * time.Hour, 0})
+	is.Nil(err)
+
+	_, err = maybe.JustAoAoS([][]string{{"2024-03-01"}, {"today"}}).ToTime(time.DateOnly).Unbox()
+	var ee *maybe.ElementError
+	is.True(errors.As(err, &ee))
+	is.Equal(ee.Index, []int{1, 0})
+
+	ragged := maybe.JustAoAoT([][]time.Time{{time.Now()}, {}})
+	is.True(ragged.Earliest().IsErr())
+	is.True(ragged.Span().IsErr())
+
+	bad := maybe.ErrAoAoT(errors.New("bad times"))
+	is.True(bad.IsErr())
+	is.True(bad.Format(time.DateOnly).IsErr())
+	is.True(bad.Latest().IsErr())
+	is.True(maybe.AoAoT{}.IsErr())
+}
@@ -0,0 +1,162 @@
+package maybe
+
+import "fmt"
+
+// AoAoU64 implements the Maybe monad for a 2-D slice of uint64s.  An AoAoU64 is
+// considered 'valid' or 'invalid' depending on whether it contains a 2-D
+// slice of uint64s or an error value.  A zero-value AoAoU64 is invalid and
+// Unbox() will return an error to that effect.
+type AoAoU64 Grid[uint64]
+
+// NewAoAoU64 constructs an AoAoU64 from a given 2-D slice of uint64s or error.
+// If e is not nil, returns ErrAoAoU64(e), otherwise returns JustAoAoU64(x).
+func NewAoAoU64(x [][]uint64, e error) AoAoU64 {
+	if e != nil {
+		return ErrAoAoU64(e)
+	}
+	return JustAoAoU64(x)
+}
+
+// JustAoAoU64 constructs a valid AoAoU64 from a given 2-D slice of uint64s.
+func JustAoAoU64(x [][]uint64) AoAoU64 {
+	return AoAoU64{just: x}
+}
+
+// ErrAoAoU64 constructs an invalid AoAoU64 from a given error.
+func ErrAoAoU64(e error) AoAoU64 {
+	return AoAoU64{err: e}
+}
+
+// NothingAoAoU64 constructs an invalid AoAoU64 that holds nothing, for a
+// missing rather than a failed value.  See ErrNothing.
+func NothingAoAoU64() AoAoU64 {
+	return AoAoU64{err: ErrNothing}
+}
+
+// IsErr returns true for an invalid AoAoU64.
+func (m AoAoU64) IsErr() bool {
+	return m.just == nil || m.err != nil
+}
+
+// Bind applies a function that takes a 2-D slice of uint64s and returns an
+// AoAoU64.
+func (m AoAoU64) Bind(f func(x [][]uint64) AoAoU64) AoAoU64 {
+	if m.IsErr() {
+		return m
+	}
+
+	return try(f, m.just, ErrAoAoU64)
+}
+
+// Join applies a function to each row of a valid AoAoU64 and returns an AoU64
+// of the results.  If the AoAoU64 is invalid or if any function returns an
+// invalid U64, Join returns an invalid AoU64.
+func (m AoAoU64) Join(f func(x []uint64) U64) AoU64 {
+	return AoU64(JoinGrid(Grid[uint64](m).checkZero("Join", "AoAoU64"), toMaybe(f)))
+}
+
+// Flatten joins a 2-D slice of uint64s into a 1-D slice
+func (m AoAoU64) Flatten() AoU64 {
+	return AoU64(Grid[uint64](m).checkZero("Flatten", "AoAoU64").Flatten())
+}
+
+// Map applies a function to each element of a valid AoAoU64 (i.e. a 1-D slice)
+// and returns a new AoAoU64.  If the AoAoU64 is invalid or if any function
+// returns an invalid AoU64, Map returns an invalid AoAoU64.
+func (m AoAoU64) Map(f func(x []uint64) AoU64) AoAoU64 {
+	return AoAoU64(MapGrid(Grid[uint64](m).checkZero("Map", "AoAoU64"), toSlice(f)))
+}
+
+// Filter returns an AoAoU64 of the rows of a valid AoAoU64 for which a function
+// returns true, in order.  If the AoAoU64 is invalid or if any function returns
+// an invalid B, Filter returns an invalid AoAoU64.
+func (m AoAoU64) Filter(f func(x []uint64) B) AoAoU64 {
+	return AoAoU64(Grid[uint64](m).checkZero("Filter", "AoAoU64").Filter(toMaybe(f)))
+}
+
+// Reject is the opposite of Filter: it returns an AoAoU64 of the rows of a
+// valid AoAoU64 for which a function returns false.
+func (m AoAoU64) Reject(f func(x []uint64) B) AoAoU64 {
+	return AoAoU64(Grid[uint64](m).checkZero("Reject", "AoAoU64").Reject(toMaybe(f)))
+}
+
+// Partition splits a valid AoAoU64 into the rows for which a function returns
+// true and those for which it returns false.  If the AoAoU64 is invalid or if
+// any function returns an invalid B, both results are invalid.
+func (m AoAoU64) Partition(f func(x []uint64) B) (AoAoU64, AoAoU64) {
+	in, out := Grid[uint64](m).checkZero("Partition", "AoAoU64").Partition(toMaybe(f))
+	return AoAoU64(in), AoAoU64(out)
+}
+
+// IsNothing returns true for an AoAoU64 that holds nothing, i.e. one whose
+// error is ErrNothing itself.  Such an AoAoU64 is also invalid, so IsErr
+// returns true.  An error that only wraps ErrNothing, such as an ElementError
+// for a missing element, is an ordinary error.
+func (m AoAoU64) IsNothing() bool {
+	return Grid[uint64](m).IsNothing()
+}
+
+// JustOr returns a valid AoAoU64 holding a default value if the AoAoU64 holds
+// nothing, or otherwise returns the AoAoU64 unchanged.  Unlike OrElse, it
+// leaves other errors in place.  A nil default is replaced by an empty one, as
+// for OrElse.
+func (m AoAoU64) JustOr(x [][]uint64) AoAoU64 {
+	return AoAoU64(Grid[uint64](m).JustOr(x))
+}
+
+// OrElse returns the AoAoU64 if it is valid, or otherwise a valid AoAoU64
+// holding a default value.  A nil default is replaced by an empty one, so the
+// result is always valid.
+func (m AoAoU64) OrElse(x [][]uint64) AoAoU64 {
+	return AoAoU64(Grid[uint64](m).OrElse(x))
+}
+
+// Recover returns the AoAoU64 if it is valid, or otherwise a valid AoAoU64
+// holding the value returned by a function that takes the error, with nil
+// replaced by an empty value as for OrElse.
+func (m AoAoU64) Recover(f func(err error) [][]uint64) AoAoU64 {
+	return AoAoU64(Grid[uint64](m).checkZero("Unbox", "AoAoU64").Recover(f))
+}
+
+// MapErr returns the AoAoU64 if it is valid, or otherwise an invalid AoAoU64
+// with the error returned by a function that takes the original error, e.g. to
+// add context with fmt.Errorf and %w.  If the function returns nil, the
+// original error is kept.
+func (m AoAoU64) MapErr(f func(err error) error) AoAoU64 {
+	return AoAoU64(Grid[uint64](m).checkZero("Unbox", "AoAoU64").MapErr(f))
+}
+
+// Catch is like Recover, but only for errors that match a target according to
+// errors.Is, and the function returns an AoAoU64, so it may fail again.  Valid
+// values and other errors are returned unchanged.
+func (m AoAoU64) Catch(target error, f func(err error) AoAoU64) AoAoU64 {
+	return AoAoU64(Grid[uint64](m).checkZero("Unbox", "AoAoU64").Catch(target, toGrid(f)))
+}
+
+// MarshalJSON implements json.Marshaler using the wire format described in
+// the package documentation.
+func (m AoAoU64) MarshalJSON() ([]byte, error) {
+	return marshalJSON(m.Unbox())
+}
+
+// UnmarshalJSON implements json.Unmarshaler using the wire format described
+// in the package documentation.
+func (m *AoAoU64) UnmarshalJSON(b []byte) error {
+	return unmarshalJSON(b, (*Maybe[[][]uint64])(m), "AoAoU64")
+}
+
+// String returns a string representation, mostly useful for debugging.
+func (m AoAoU64) String() string {
+	if m.IsErr() {
+		return fmt.Sprintf("Err %v", m.err)
+	}
+	return fmt.Sprintf("Just %v", m.just)
+}
+
+// Unbox returns the underlying 2-D slice of uint64s or error.
+func (m AoAoU64) Unbox() ([][]uint64, error) {
+	if m.just == nil && m.err == nil {
+		return nil, &OpError{Op: "Unbox", Type: "AoAoU64", Err: ErrZeroValue}
+	}
+	return m.just, m.err
+}
@@ -0,0 +1,41 @@
+package maybe_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/xdg/maybe"
+	"github.com/xdg/testy"
+)
+
+func TestAoAoU64(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	input := [][]uint64{{1, 2}, {3}}
+	good := maybe.JustAoAoU64(input)
+	bad := maybe.ErrAoAoU64(errors.New("bad uint64s"))
+
+	just, err := good.Unbox()
+	is.Equal(just, input)
+	is.Nil(err)
+	is.False(good.IsErr())
+
+	just, err = bad.Unbox()
+	is.Nil(just)
+	is.Equal(err.Error(), "bad uint64s")
+	is.True(bad.IsErr())
+
+	is.Equal(maybe.NewAoAoU64(input, nil), good)
+	is.True(maybe.NewAoAoU64(nil, err).IsErr())
+	is.Equal(good.String(), "Just [[1 2] [3]]")
+
+	flat, err := good.Flatten().Unbox()
+	is.Equal(flat, []uint64{1, 2, 3})
+	is.Nil(err)
+
+	zero := maybe.AoAoU64{}
+	is.True(zero.IsErr())
+	_, err = zero.Unbox()
+	is.NotNil(err)
+}
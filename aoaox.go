@@ -1,7 +1,7 @@
 package maybe
 
 import (
-	"errors"
+	"context"
 	"fmt"
 	"reflect"
 )
@@ -10,10 +10,7 @@ import (
 // considered 'valid' or 'invalid' depending on whether it contains a 2-D
 // slice of empty interfaces or an error value.  A zero-value AoAoX is invalid and Unbox()
 // will return an error to that effect.
-type AoAoX struct {
-	just [][]interface{}
-	err  error
-}
+type AoAoX Grid[interface{}]
 
 // NewAoAoX constructs an AoAoX from a given 2-D slice of empty interfaces or error. If e is not
 // nil, returns ErrAoAoX(e), otherwise returns JustAoAoX(x).
@@ -24,7 +21,7 @@ func NewAoAoX(x [][]interface{}, e error) AoAoX {
 	return JustAoAoX(x)
 }
 
-var errAoAoXNotSlice = errors.New("NewAoAoXFromSlice called with non-slice-of-slices")
+var errAoAoXNotSlice error = &OpError{Op: "NewAoAoXFromSlice", Type: "AoAoX", Err: ErrNotSlice}
 
 // NewAoAoXFromSlice constructs an AoAoX from a given slice of slices of
 // arbitrary values or error.  If e is not nil, returns ErrAoAoX(e),
@@ -65,6 +62,12 @@ func ErrAoAoX(e error) AoAoX {
 	return AoAoX{err: e}
 }
 
+// NothingAoAoX constructs an invalid AoAoX that holds nothing, for a missing
+// rather than a failed value.  See ErrNothing.
+func NothingAoAoX() AoAoX {
+	return AoAoX{err: ErrNothing}
+}
+
 // IsErr returns true for an invalid AoAoX.
 func (m AoAoX) IsErr() bool {
 	return m.just == nil || m.err != nil
@@ -76,59 +79,194 @@ func (m AoAoX) Bind(f func(x [][]interface{}) AoAoX) AoAoX {
 		return m
 	}
 
-	return f(m.just)
+	return try(f, m.just, ErrAoAoX)
 }
 
-// Join applies a function that takes a 2-D slice of empty interfaces and returns an AoX.
-func (m AoAoX) Join(f func(x []interface{}) X) AoX {
+// BindCtx is like Bind, but the function also takes a context.  If the
+// context is done, BindCtx returns an invalid AoAoX with the context's error
+// instead of calling the function.
+func (m AoAoX) BindCtx(ctx context.Context, f func(ctx context.Context, x [][]interface{}) AoAoX) AoAoX {
 	if m.IsErr() {
-		return ErrAoX(m.err)
+		return m
 	}
-
-	xss := make([]interface{}, len(m.just))
-	for i, v := range m.just {
-		s, err := f(v).Unbox()
-		if err != nil {
-			return ErrAoX(err)
-		}
-		xss[i] = s
+	if err := ctx.Err(); err != nil {
+		return ErrAoAoX(err)
 	}
 
-	return JustAoX(xss)
+	return tryCtx(ctx, f, m.just, ErrAoAoX)
 }
 
-// Flatten joins a 2-D slice of empty interfaces into a 1-D slice
-func (m AoAoX) Flatten() AoX {
-	if m.IsErr() {
-		return ErrAoX(m.err)
-	}
+// Join applies a function that takes a 2-D slice of empty interfaces and returns an AoX.
+func (m AoAoX) Join(f func(x []interface{}) X) AoX {
+	return AoX(JoinGrid(Grid[interface{}](m).checkZero("Join", "AoAoX"), toMaybe(f)))
+}
 
-	xs := make([]interface{}, 0)
-	for _, v := range m.just {
-		xs = append(xs, v...)
-	}
+// JoinCtx is like Join, but the function also takes a context.  The context
+// is checked before each row; once it is done, JoinCtx stops and returns an
+// invalid AoX with the context's error.
+func (m AoAoX) JoinCtx(ctx context.Context, f func(ctx context.Context, x []interface{}) X) AoX {
+	return AoX(JoinGridCtx(ctx, Grid[interface{}](m).checkZero("JoinCtx", "AoAoX"), toMaybeCtx(f)))
+}
 
-	return JustAoX(xs)
+// JoinAll is like Join, but applies the function to every row even after a
+// failure.  If any function returns an invalid X, JoinAll returns an
+// invalid AoX whose error joins the errors from all failing rows, each tagged
+// with its row index.
+func (m AoAoX) JoinAll(f func(x []interface{}) X) AoX {
+	return AoX(JoinGridAll(Grid[interface{}](m).checkZero("JoinAll", "AoAoX"), toMaybe(f)))
+}
+
+// Flatten joins a 2-D slice of empty interfaces into a 1-D slice
+func (m AoAoX) Flatten() AoX {
+	return AoX(Grid[interface{}](m).checkZero("Flatten", "AoAoX").Flatten())
 }
 
 // Map applies a function to each element of a valid AoAoX (i.e. a 1-D slice)
 // and returns a new AoAoX.  If the AoAoX is invalid or if any function
 // returns an invalid AoX, Map returns an invalid AoAoX.
 func (m AoAoX) Map(f func(x []interface{}) AoX) AoAoX {
-	if m.IsErr() {
-		return m
-	}
+	return AoAoX(MapGrid(Grid[interface{}](m).checkZero("Map", "AoAoX"), toSlice(f)))
+}
+
+// Filter returns an AoAoX of the rows of a valid AoAoX for which a function
+// returns true, in order.  If the AoAoX is invalid or if any function returns
+// an invalid B, Filter returns an invalid AoAoX.
+func (m AoAoX) Filter(f func(x []interface{}) B) AoAoX {
+	return AoAoX(Grid[interface{}](m).checkZero("Filter", "AoAoX").Filter(toMaybe(f)))
+}
+
+// Reject is the opposite of Filter: it returns an AoAoX of the rows of a valid
+// AoAoX for which a function returns false.
+func (m AoAoX) Reject(f func(x []interface{}) B) AoAoX {
+	return AoAoX(Grid[interface{}](m).checkZero("Reject", "AoAoX").Reject(toMaybe(f)))
+}
 
-	xss := make([][]interface{}, len(m.just))
-	for i, v := range m.just {
-		x, err := f(v).Unbox()
-		if err != nil {
-			return ErrAoAoX(err)
+// Partition splits a valid AoAoX into the rows for which a function returns
+// true and those for which it returns false.  If the AoAoX is invalid or if any
+// function returns an invalid B, both results are invalid.
+func (m AoAoX) Partition(f func(x []interface{}) B) (AoAoX, AoAoX) {
+	in, out := Grid[interface{}](m).checkZero("Partition", "AoAoX").Partition(toMaybe(f))
+	return AoAoX(in), AoAoX(out)
+}
+
+// FoldRows folds each row of a valid AoAoX into a single value, as AoX.Fold
+// does, resulting in an AoX with one value per row.  If the AoAoX is invalid or
+// if any function returns an invalid X, FoldRows returns an invalid AoX.
+func (m AoAoX) FoldRows(init interface{}, f func(acc interface{}, x interface{}) X) AoX {
+	return AoX(FoldGridRows(Grid[interface{}](m).checkZero("FoldRows", "AoAoX"), init, toMaybe2(f)))
+}
+
+// FoldCols folds each column of a valid AoAoX into a single value, going down
+// the rows, resulting in an AoX with one value per column.  All rows must
+// have the same length; otherwise, FoldCols returns an invalid AoX with an
+// error wrapping ErrRagged.  If the AoAoX is invalid or if any function returns
+// an invalid X, FoldCols returns an invalid AoX.
+func (m AoAoX) FoldCols(init interface{}, f func(acc interface{}, x interface{}) X) AoX {
+	return AoX(FoldGridCols(Grid[interface{}](m).checkZero("FoldCols", "AoAoX"), init, toMaybe2(f)))
+}
+
+// Unzip is the opposite of AoX.Zip: it splits a valid AoAoX whose rows each
+// hold exactly two elements into an AoX of the first elements and an AoX of the
+// second elements.  If the AoAoX is invalid or any row has a different
+// length, both results are invalid.
+func (m AoAoX) Unzip() (AoX, AoX) {
+	xs, ys := Grid[interface{}](m).checkZero("Unzip", "AoAoX").Unzip()
+	return AoX(xs), AoX(ys)
+}
+
+// MapCtx is like Map, but the function also takes a context.  The context is
+// checked before each row; once it is done, MapCtx stops and returns an
+// invalid AoAoX with the context's error.
+func (m AoAoX) MapCtx(ctx context.Context, f func(ctx context.Context, x []interface{}) AoX) AoAoX {
+	return AoAoX(MapGridCtx(ctx, Grid[interface{}](m).checkZero("MapCtx", "AoAoX"), toSliceCtx(f)))
+}
+
+// MapAll is like Map, but applies the function to every row even after a
+// failure.  If any function returns an invalid AoX, MapAll returns an
+// invalid AoAoX whose error joins the errors from all failing rows, each
+// tagged with its row index.
+func (m AoAoX) MapAll(f func(x []interface{}) AoX) AoAoX {
+	return AoAoX(MapGridAll(Grid[interface{}](m).checkZero("MapAll", "AoAoX"), toSlice(f)))
+}
+
+// ParallelMap is like Map, but runs the function on up to n rows
+// concurrently, or GOMAXPROCS rows if n is less than 1.  The order of rows is
+// preserved.  Once a function returns an invalid AoX, no further rows are
+// started and ParallelMap returns an invalid AoAoX.
+func (m AoAoX) ParallelMap(n int, f func(x []interface{}) AoX) AoAoX {
+	return AoAoX(ParallelMapGrid(Grid[interface{}](m).checkZero("ParallelMap", "AoAoX"), n, toSlice(f)))
+}
+
+// ToMoX converts a valid AoAoX of key/value pairs to a MoX.  Each row must
+// have exactly two elements, the first of which must be a string.  If unique
+// is true, a repeated key makes the result invalid with an error wrapping
+// ErrDuplicateKey; otherwise, later rows win.  If the AoAoX is invalid or any
+// row fails, ToMoX returns an invalid MoX with an ElementError for the row.
+func (m AoAoX) ToMoX(unique bool) MoX {
+	key := func(x interface{}) Maybe[string] {
+		if s, ok := x.(string); ok {
+			return Just(s)
 		}
-		xss[i] = x
+		return Err[string](fmt.Errorf("key is %T, not string", x))
 	}
+	return MoX(GridToDict(Grid[interface{}](m).checkZero("ToMoX", "AoAoX"), key, Just[interface{}], unique))
+}
+
+// IsNothing returns true for an AoAoX that holds nothing, i.e. one whose error
+// is ErrNothing itself.  Such an AoAoX is also invalid, so IsErr returns true.
+// An error that only wraps ErrNothing, such as an ElementError for a missing
+// element, is an ordinary error.
+func (m AoAoX) IsNothing() bool {
+	return Grid[interface{}](m).IsNothing()
+}
+
+// JustOr returns a valid AoAoX holding a default value if the AoAoX holds
+// nothing, or otherwise returns the AoAoX unchanged.  Unlike OrElse, it leaves
+// other errors in place.  A nil default is replaced by an empty one, as for
+// OrElse.
+func (m AoAoX) JustOr(x [][]interface{}) AoAoX {
+	return AoAoX(Grid[interface{}](m).JustOr(x))
+}
+
+// OrElse returns the AoAoX if it is valid, or otherwise a valid AoAoX holding a
+// default value.  A nil default is replaced by an empty one, so the result is
+// always valid.
+func (m AoAoX) OrElse(x [][]interface{}) AoAoX {
+	return AoAoX(Grid[interface{}](m).OrElse(x))
+}
+
+// Recover returns the AoAoX if it is valid, or otherwise a valid AoAoX holding
+// the value returned by a function that takes the error, with nil replaced by
+// an empty value as for OrElse.
+func (m AoAoX) Recover(f func(err error) [][]interface{}) AoAoX {
+	return AoAoX(Grid[interface{}](m).checkZero("Unbox", "AoAoX").Recover(f))
+}
+
+// MapErr returns the AoAoX if it is valid, or otherwise an invalid AoAoX with
+// the error returned by a function that takes the original error, e.g. to add
+// context with fmt.Errorf and %w.  If the function returns nil, the original
+// error is kept.
+func (m AoAoX) MapErr(f func(err error) error) AoAoX {
+	return AoAoX(Grid[interface{}](m).checkZero("Unbox", "AoAoX").MapErr(f))
+}
+
+// Catch is like Recover, but only for errors that match a target according
+// to errors.Is, and the function returns an AoAoX, so it may fail again.  Valid
+// values and other errors are returned unchanged.
+func (m AoAoX) Catch(target error, f func(err error) AoAoX) AoAoX {
+	return AoAoX(Grid[interface{}](m).checkZero("Unbox", "AoAoX").Catch(target, toGrid(f)))
+}
+
+// MarshalJSON implements json.Marshaler using the wire format described in
+// the package documentation.
+func (m AoAoX) MarshalJSON() ([]byte, error) {
+	return marshalJSON(m.Unbox())
+}
 
-	return JustAoAoX(xss)
+// UnmarshalJSON implements json.Unmarshaler using the wire format described
+// in the package documentation.
+func (m *AoAoX) UnmarshalJSON(b []byte) error {
+	return unmarshalJSON(b, (*Maybe[[][]interface{}])(m), "AoAoX")
 }
 
 // String returns a string representation, mostly useful for debugging.
@@ -142,7 +280,7 @@ func (m AoAoX) String() string {
 // Unbox returns the underlying 2-D slice of empty interfaces or error.
 func (m AoAoX) Unbox() ([][]interface{}, error) {
 	if m.just == nil && m.err == nil {
-		return nil, errors.New("zero-value AoAoX")
+		return nil, &OpError{Op: "Unbox", Type: "AoAoX", Err: ErrZeroValue}
 	}
 	return m.just, m.err
 }
@@ -216,3 +216,24 @@ func TestAoAoXFlatten(t *testing.T) {
 	got = bad.Flatten()
 	is.True(got.IsErr())
 }
+
+func TestAoAoXFold(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	sum := func(acc, x interface{}) maybe.X {
+		n, ok := x.(int)
+		if !ok {
+			return maybe.ErrX(errors.New("not an int"))
+		}
+		return maybe.JustX(acc.(int) + n)
+	}
+	good := maybe.JustAoAoX([][]interface{}{{1, 2}, {3, 4}})
+
+	is.Equal(good.FoldRows(0, sum), maybe.JustAoX([]interface{}{3, 7}))
+	is.Equal(good.FoldCols(0, sum), maybe.JustAoX([]interface{}{4, 6}))
+
+	_, err := maybe.JustAoAoX([][]interface{}{{1, "a"}}).FoldRows(0, sum).Unbox()
+	is.Equal(err.Error(), "element [0 1]: not an int")
+	is.True(maybe.ErrAoAoX(errors.New("bad")).FoldCols(0, sum).IsErr())
+}
@@ -0,0 +1,207 @@
+package maybe
+
+import "fmt"
+
+// AoB implements the Maybe monad for a slice of bools.  An AoB is
+// considered 'valid' or 'invalid' depending on whether it contains a slice of
+// bools or an error value.  A zero-value AoB is invalid and Unbox() will
+// return an error to that effect.
+type AoB Slice[bool]
+
+// NewAoB constructs an AoB from a given slice of bools or error. If e is
+// not nil, returns ErrAoB(e), otherwise returns JustAoB(x).
+func NewAoB(x []bool, e error) AoB {
+	if e != nil {
+		return ErrAoB(e)
+	}
+	return JustAoB(x)
+}
+
+// JustAoB constructs a valid AoB from a given slice of bools.
+func JustAoB(x []bool) AoB {
+	return AoB{just: x}
+}
+
+// ErrAoB constructs an invalid AoB from a given error.
+func ErrAoB(e error) AoB {
+	return AoB{err: e}
+}
+
+// NothingAoB constructs an invalid AoB that holds nothing, for a missing rather
+// than a failed value.  See ErrNothing.
+func NothingAoB() AoB {
+	return AoB{err: ErrNothing}
+}
+
+// IsErr returns true for an invalid AoB.
+func (m AoB) IsErr() bool {
+	return m.just == nil || m.err != nil
+}
+
+// Bind applies a function that takes a slice of bools and returns an AoB.
+func (m AoB) Bind(f func(x []bool) AoB) AoB {
+	if m.IsErr() {
+		return m
+	}
+
+	return try(f, m.just, ErrAoB)
+}
+
+// Join applies a function that takes a slice of bools and returns a B.
+func (m AoB) Join(f func(x []bool) B) B {
+	if m.IsErr() {
+		return ErrB(zeroErr(m.err, "Join", "AoB"))
+	}
+
+	return try(f, m.just, ErrB)
+}
+
+// Split applies a splitting function to each element of a valid AoB,
+// resulting in a higher-dimension structure. If the AoB is invalid or if any
+// function returns an invalid AoB, Split returns an invalid AoAoB.
+func (m AoB) Split(f func(x bool) AoB) AoAoB {
+	return AoAoB(SplitSlice(Slice[bool](m).checkZero("Split", "AoB"), toSlice(f)))
+}
+
+// Map applies a function to each element of a valid AoB and returns a new
+// AoB.  If the AoB is invalid or if any function returns an invalid B, Map
+// returns an invalid AoB.
+func (m AoB) Map(f func(x bool) B) AoB {
+	return AoB(MapSlice(Slice[bool](m).checkZero("Map", "AoB"), toMaybe(f)))
+}
+
+// Filter returns an AoB of the elements of a valid AoB for which a function
+// returns true, in order.  If the AoB is invalid or if any function returns an
+// invalid B, Filter returns an invalid AoB.
+func (m AoB) Filter(f func(x bool) B) AoB {
+	return AoB(Slice[bool](m).checkZero("Filter", "AoB").Filter(toMaybe(f)))
+}
+
+// Reject is the opposite of Filter: it returns an AoB of the elements of a
+// valid AoB for which a function returns false.
+func (m AoB) Reject(f func(x bool) B) AoB {
+	return AoB(Slice[bool](m).checkZero("Reject", "AoB").Reject(toMaybe(f)))
+}
+
+// Partition splits a valid AoB into the elements for which a function returns
+// true and those for which it returns false.  If the AoB is invalid or if any
+// function returns an invalid B, both results are invalid.
+func (m AoB) Partition(f func(x bool) B) (AoB, AoB) {
+	in, out := Slice[bool](m).checkZero("Partition", "AoB").Partition(toMaybe(f))
+	return AoB(in), AoB(out)
+}
+
+// All returns a B that is true if every element of a valid AoB is true,
+// including when there are none.  If the AoB is invalid, All returns an
+// invalid B.
+func (m AoB) All() B {
+	return m.Join(func(xs []bool) B {
+		for _, v := range xs {
+			if !v {
+				return JustB(false)
+			}
+		}
+		return JustB(true)
+	})
+}
+
+// Any returns a B that is true if at least one element of a valid AoB is
+// true.  If the AoB is invalid, Any returns an invalid B.
+func (m AoB) Any() B {
+	return m.Join(func(xs []bool) B {
+		for _, v := range xs {
+			if v {
+				return JustB(true)
+			}
+		}
+		return JustB(false)
+	})
+}
+
+// Count returns an I with the number of true elements of a valid AoB.  If
+// the AoB is invalid, Count returns an invalid I.
+func (m AoB) Count() I {
+	if m.IsErr() {
+		return ErrI(zeroErr(m.err, "Count", "AoB"))
+	}
+
+	n := 0
+	for _, v := range m.just {
+		if v {
+			n++
+		}
+	}
+	return JustI(n)
+}
+
+// IsNothing returns true for an AoB that holds nothing, i.e. one whose error is
+// ErrNothing itself.  Such an AoB is also invalid, so IsErr returns true.  An
+// error that only wraps ErrNothing, such as an ElementError for a missing
+// element, is an ordinary error.
+func (m AoB) IsNothing() bool {
+	return Slice[bool](m).IsNothing()
+}
+
+// JustOr returns a valid AoB holding a default value if the AoB holds nothing,
+// or otherwise returns the AoB unchanged.  Unlike OrElse, it leaves other
+// errors in place.  A nil default is replaced by an empty one, as for OrElse.
+func (m AoB) JustOr(x []bool) AoB {
+	return AoB(Slice[bool](m).JustOr(x))
+}
+
+// OrElse returns the AoB if it is valid, or otherwise a valid AoB holding a
+// default value.  A nil default is replaced by an empty one, so the result is
+// always valid.
+func (m AoB) OrElse(x []bool) AoB {
+	return AoB(Slice[bool](m).OrElse(x))
+}
+
+// Recover returns the AoB if it is valid, or otherwise a valid AoB holding the
+// value returned by a function that takes the error, with nil replaced by an
+// empty value as for OrElse.
+func (m AoB) Recover(f func(err error) []bool) AoB {
+	return AoB(Slice[bool](m).checkZero("Unbox", "AoB").Recover(f))
+}
+
+// MapErr returns the AoB if it is valid, or otherwise an invalid AoB with the
+// error returned by a function that takes the original error, e.g. to add
+// context with fmt.Errorf and %w.  If the function returns nil, the original
+// error is kept.
+func (m AoB) MapErr(f func(err error) error) AoB {
+	return AoB(Slice[bool](m).checkZero("Unbox", "AoB").MapErr(f))
+}
+
+// Catch is like Recover, but only for errors that match a target according
+// to errors.Is, and the function returns an AoB, so it may fail again.  Valid
+// values and other errors are returned unchanged.
+func (m AoB) Catch(target error, f func(err error) AoB) AoB {
+	return AoB(Slice[bool](m).checkZero("Unbox", "AoB").Catch(target, toSlice(f)))
+}
+
+// MarshalJSON implements json.Marshaler using the wire format described in
+// the package documentation.
+func (m AoB) MarshalJSON() ([]byte, error) {
+	return marshalJSON(m.Unbox())
+}
+
+// UnmarshalJSON implements json.Unmarshaler using the wire format described
+// in the package documentation.
+func (m *AoB) UnmarshalJSON(b []byte) error {
+	return unmarshalJSON(b, (*Maybe[[]bool])(m), "AoB")
+}
+
+// String returns a string representation, mostly useful for debugging.
+func (m AoB) String() string {
+	if m.IsErr() {
+		return fmt.Sprintf("Err %v", m.err)
+	}
+	return fmt.Sprintf("Just %v", m.just)
+}
+
+// Unbox returns the underlying slice of bools or error.
+func (m AoB) Unbox() ([]bool, error) {
+	if m.just == nil && m.err == nil {
+		return nil, &OpError{Op: "Unbox", Type: "AoB", Err: ErrZeroValue}
+	}
+	return m.just, m.err
+}
@@ -0,0 +1,101 @@
+package maybe_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/xdg/maybe"
+	"github.com/xdg/testy"
+)
+
+func getBoolFixtures(input []bool) (good, bad maybe.AoB) {
+	good = maybe.JustAoB(input)
+	bad = maybe.ErrAoB(errors.New("bad bool"))
+	return
+}
+
+func TestAoB(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	input := []bool{true, false}
+	good, bad := getBoolFixtures(input)
+	var got maybe.AoB
+	var just []bool
+	var err error
+
+	just, err = good.Unbox()
+	is.Equal(just, input)
+	is.Nil(err)
+	is.False(good.IsErr())
+
+	just, err = bad.Unbox()
+	is.Nil(just)
+	is.NotNil(err)
+	is.Equal(err.Error(), "bad bool")
+	is.True(bad.IsErr())
+
+	got = maybe.NewAoB(input, nil)
+	is.Equal(got, good)
+
+	got = maybe.NewAoB(nil, err)
+	is.True(got.IsErr())
+
+	is.Equal(good.String(), "Just [true false]")
+	is.Equal(bad.String(), "Err bad bool")
+
+	zero := maybe.AoB{}
+	is.True(zero.IsErr())
+	_, err = zero.Unbox()
+	is.NotNil(err)
+
+	not := func(x bool) maybe.B { return maybe.JustB(!x) }
+	just, err = good.Map(not).Unbox()
+	is.Equal(just, []bool{false, true})
+	is.Nil(err)
+	is.True(bad.Map(not).IsErr())
+}
+
+func TestAoBAggregates(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	cases := []struct {
+		input []bool
+		all   bool
+		any   bool
+		count int
+	}{
+		{[]bool{true, true}, true, true, 2},
+		{[]bool{true, false, true}, false, true, 2},
+		{[]bool{false, false}, false, false, 0},
+		{[]bool{}, true, false, 0},
+	}
+
+	for _, c := range cases {
+		m := maybe.JustAoB(c.input)
+		is.Equal(m.All(), maybe.JustB(c.all))
+		is.Equal(m.Any(), maybe.JustB(c.any))
+		is.Equal(m.Count(), maybe.JustI(c.count))
+	}
+
+	_, bad := getBoolFixtures(nil)
+	is.True(bad.All().IsErr())
+	is.True(bad.Any().IsErr())
+	is.True(bad.Count().IsErr())
+}
+
+func TestAoBFromPredicate(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	positive := func(x int) maybe.B { return maybe.JustB(x > 0) }
+	nonEmpty := func(s string) maybe.B { return maybe.JustB(s != "") }
+
+	mask := maybe.JustAoI([]int{3, -1, 4}).ToBool(positive)
+	is.Equal(mask, maybe.JustAoB([]bool{true, false, true}))
+	is.Equal(mask.Count(), maybe.JustI(2))
+
+	is.Equal(maybe.JustAoS([]string{"a", ""}).ToBool(nonEmpty).All(), maybe.JustB(false))
+	is.True(maybe.ErrAoS(errors.New("bad strings")).ToBool(nonEmpty).IsErr())
+}
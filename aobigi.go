@@ -0,0 +1,190 @@
+package maybe
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// AoBigI implements the Maybe monad for a slice of *big.Ints.  An AoBigI is
+// considered 'valid' or 'invalid' depending on whether it contains a slice of
+// *big.Ints or an error value.  A zero-value AoBigI is invalid and Unbox() will
+// return an error to that effect.
+type AoBigI Slice[*big.Int]
+
+// NewAoBigI constructs an AoBigI from a given slice of *big.Ints or error. If e
+// is not nil, returns ErrAoBigI(e), otherwise returns JustAoBigI(x).
+func NewAoBigI(x []*big.Int, e error) AoBigI {
+	if e != nil {
+		return ErrAoBigI(e)
+	}
+	return JustAoBigI(x)
+}
+
+// JustAoBigI constructs a valid AoBigI from a given slice of *big.Ints.
+func JustAoBigI(x []*big.Int) AoBigI {
+	return AoBigI{just: x}
+}
+
+// ErrAoBigI constructs an invalid AoBigI from a given error.
+func ErrAoBigI(e error) AoBigI {
+	return AoBigI{err: e}
+}
+
+// NothingAoBigI constructs an invalid AoBigI that holds nothing, for a missing
+// rather than a failed value.  See ErrNothing.
+func NothingAoBigI() AoBigI {
+	return AoBigI{err: ErrNothing}
+}
+
+// IsErr returns true for an invalid AoBigI.
+func (m AoBigI) IsErr() bool {
+	return m.just == nil || m.err != nil
+}
+
+// Bind applies a function that takes a slice of *big.Ints and returns an
+// AoBigI.
+func (m AoBigI) Bind(f func(x []*big.Int) AoBigI) AoBigI {
+	if m.IsErr() {
+		return m
+	}
+
+	return try(f, m.just, ErrAoBigI)
+}
+
+// Join applies a function that takes a slice of *big.Ints and returns a BigI.
+func (m AoBigI) Join(f func(x []*big.Int) BigI) BigI {
+	if m.IsErr() {
+		return ErrBigI(zeroErr(m.err, "Join", "AoBigI"))
+	}
+
+	return try(f, m.just, ErrBigI)
+}
+
+// Split applies a splitting function to each element of a valid AoBigI,
+// resulting in a higher-dimension structure. If the AoBigI is invalid or if any
+// function returns an invalid AoBigI, Split returns an invalid AoAoBigI.
+func (m AoBigI) Split(f func(x *big.Int) AoBigI) AoAoBigI {
+	return AoAoBigI(SplitSlice(Slice[*big.Int](m).checkZero("Split", "AoBigI"), toSlice(f)))
+}
+
+// Map applies a function to each element of a valid AoBigI and returns a new
+// AoBigI.  If the AoBigI is invalid or if any function returns an invalid BigI,
+// Map returns an invalid AoBigI.
+func (m AoBigI) Map(f func(x *big.Int) BigI) AoBigI {
+	return AoBigI(MapSlice(Slice[*big.Int](m).checkZero("Map", "AoBigI"), toMaybe(f)))
+}
+
+// Filter returns an AoBigI of the elements of a valid AoBigI for which a
+// function returns true, in order.  If the AoBigI is invalid or if any function
+// returns an invalid B, Filter returns an invalid AoBigI.
+func (m AoBigI) Filter(f func(x *big.Int) B) AoBigI {
+	return AoBigI(Slice[*big.Int](m).checkZero("Filter", "AoBigI").Filter(toMaybe(f)))
+}
+
+// Reject is the opposite of Filter: it returns an AoBigI of the elements of a
+// valid AoBigI for which a function returns false.
+func (m AoBigI) Reject(f func(x *big.Int) B) AoBigI {
+	return AoBigI(Slice[*big.Int](m).checkZero("Reject", "AoBigI").Reject(toMaybe(f)))
+}
+
+// Partition splits a valid AoBigI into the elements for which a function
+// returns true and those for which it returns false.  If the AoBigI is invalid
+// or if any function returns an invalid B, both results are invalid.
+func (m AoBigI) Partition(f func(x *big.Int) B) (AoBigI, AoBigI) {
+	in, out := Slice[*big.Int](m).checkZero("Partition", "AoBigI").Partition(toMaybe(f))
+	return AoBigI(in), AoBigI(out)
+}
+
+// ToI converts each element of a valid AoBigI to int, resulting in an AoI.  If
+// the AoBigI is invalid or any element is nil or out of range, ToI returns an
+// invalid AoI.
+func (m AoBigI) ToI() AoI {
+	return AoI(MapSlice(Slice[*big.Int](m).checkZero("ToI", "AoBigI"), bigIToI))
+}
+
+// ToI64 converts each element of a valid AoBigI to int64, resulting in an
+// AoI64.  If the AoBigI is invalid or any element is nil or out of range, ToI64
+// returns an invalid AoI64.
+func (m AoBigI) ToI64() AoI64 {
+	return AoI64(MapSlice(Slice[*big.Int](m).checkZero("ToI64", "AoBigI"), bigIToI64))
+}
+
+// ToU64 converts each element of a valid AoBigI to uint64, resulting in an
+// AoU64.  If the AoBigI is invalid or any element is nil or out of range, ToU64
+// returns an invalid AoU64.
+func (m AoBigI) ToU64() AoU64 {
+	return AoU64(MapSlice(Slice[*big.Int](m).checkZero("ToU64", "AoBigI"), bigIToU64))
+}
+
+// IsNothing returns true for an AoBigI that holds nothing, i.e. one whose error
+// is ErrNothing itself.  Such an AoBigI is also invalid, so IsErr returns true.
+// An error that only wraps ErrNothing, such as an ElementError for a missing
+// element, is an ordinary error.
+func (m AoBigI) IsNothing() bool {
+	return Slice[*big.Int](m).IsNothing()
+}
+
+// JustOr returns a valid AoBigI holding a default value if the AoBigI holds
+// nothing, or otherwise returns the AoBigI unchanged.  Unlike OrElse, it leaves
+// other errors in place.  A nil default is replaced by an empty one, as for
+// OrElse.
+func (m AoBigI) JustOr(x []*big.Int) AoBigI {
+	return AoBigI(Slice[*big.Int](m).JustOr(x))
+}
+
+// OrElse returns the AoBigI if it is valid, or otherwise a valid AoBigI holding
+// a default value.  A nil default is replaced by an empty one, so the result is
+// always valid.
+func (m AoBigI) OrElse(x []*big.Int) AoBigI {
+	return AoBigI(Slice[*big.Int](m).OrElse(x))
+}
+
+// Recover returns the AoBigI if it is valid, or otherwise a valid AoBigI
+// holding the value returned by a function that takes the error, with nil
+// replaced by an empty value as for OrElse.
+func (m AoBigI) Recover(f func(err error) []*big.Int) AoBigI {
+	return AoBigI(Slice[*big.Int](m).checkZero("Unbox", "AoBigI").Recover(f))
+}
+
+// MapErr returns the AoBigI if it is valid, or otherwise an invalid AoBigI with
+// the error returned by a function that takes the original error, e.g. to add
+// context with fmt.Errorf and %w.  If the function returns nil, the original
+// error is kept.
+func (m AoBigI) MapErr(f func(err error) error) AoBigI {
+	return AoBigI(Slice[*big.Int](m).checkZero("Unbox", "AoBigI").MapErr(f))
+}
+
+// Catch is like Recover, but only for errors that match a target according to
+// errors.Is, and the function returns an AoBigI, so it may fail again.  Valid
+// values and other errors are returned unchanged.
+func (m AoBigI) Catch(target error, f func(err error) AoBigI) AoBigI {
+	return AoBigI(Slice[*big.Int](m).checkZero("Unbox", "AoBigI").Catch(target, toSlice(f)))
+}
+
+// MarshalJSON implements json.Marshaler using the wire format described in
+// the package documentation.
+func (m AoBigI) MarshalJSON() ([]byte, error) {
+	return marshalJSON(m.Unbox())
+}
+
+// UnmarshalJSON implements json.Unmarshaler using the wire format described
+// in the package documentation.
+func (m *AoBigI) UnmarshalJSON(b []byte) error {
+	return unmarshalJSON(b, (*Maybe[[]*big.Int])(m), "AoBigI")
+}
+
+// String returns a string representation, mostly useful for debugging.
+func (m AoBigI) String() string {
+	if m.IsErr() {
+		return fmt.Sprintf("Err %v", m.err)
+	}
+	return fmt.Sprintf("Just %v", m.just)
+}
+
+// Unbox returns the underlying slice of *big.Ints or error.
+func (m AoBigI) Unbox() ([]*big.Int, error) {
+	if m.just == nil && m.err == nil {
+		return nil, &OpError{Op: "Unbox", Type: "AoBigI", Err: ErrZeroValue}
+	}
+	return m.just, m.err
+}
@@ -0,0 +1,59 @@
+package maybe_test
+
+import (
+	"errors"
+	"math/big"
+	"strconv"
+	"testing"
+
+	"github.com/xdg/maybe"
+	"github.com/xdg/testy"
+)
+
+func TestAoBigI(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	input := []*big.Int{big.NewInt(1), big.NewInt(-2)}
+	good := maybe.JustAoBigI(input)
+	bad := maybe.ErrAoBigI(errors.New("bad big ints"))
+
+	just, err := good.Unbox()
+	is.Equal(just, input)
+	is.Nil(err)
+	is.False(good.IsErr())
+
+	just, err = bad.Unbox()
+	is.Nil(just)
+	is.Equal(err.Error(), "bad big ints")
+	is.True(bad.IsErr())
+
+	is.Equal(maybe.NewAoBigI(input, nil), good)
+	is.True(maybe.NewAoBigI(nil, err).IsErr())
+	is.Equal(good.String(), "Just [1 -2]")
+
+	zero := maybe.AoBigI{}
+	is.True(zero.IsErr())
+	_, err = zero.Unbox()
+	is.NotNil(err)
+
+	xs, err := good.ToI().Unbox()
+	is.Equal(xs, []int{1, -2})
+	is.Nil(err)
+	i64s, err := good.ToI64().Unbox()
+	is.Equal(i64s, []int64{1, -2})
+	is.Nil(err)
+	_, err = good.ToU64().Unbox()
+	is.Equal(err.Error(), `element [1]: strconv.ToU64: parsing "-2": value out of range`)
+
+	_, err = maybe.JustAoS([]string{"1", "x"}).ToBigI().Unbox()
+	var ee *maybe.ElementError
+	is.True(errors.As(err, &ee))
+	is.Equal(ee.Index, []int{1})
+	is.True(errors.Is(err, strconv.ErrSyntax))
+
+	is.True(maybe.JustAoBigI([]*big.Int{nil}).ToI64().IsErr())
+	is.True(bad.ToI().IsErr())
+	is.True(bad.ToI64().IsErr())
+	is.True(bad.ToU64().IsErr())
+}
@@ -0,0 +1,193 @@
+package maybe
+
+import (
+	"fmt"
+	"time"
+)
+
+// AoD implements the Maybe monad for a slice of durations.  An AoD is
+// considered 'valid' or 'invalid' depending on whether it contains a slice of
+// durations or an error value.  A zero-value AoD is invalid and Unbox() will
+// return an error to that effect.
+type AoD Slice[time.Duration]
+
+// NewAoD constructs an AoD from a given slice of durations or error. If e is
+// not nil, returns ErrAoD(e), otherwise returns JustAoD(x).
+func NewAoD(x []time.Duration, e error) AoD {
+	if e != nil {
+		return ErrAoD(e)
+	}
+	return JustAoD(x)
+}
+
+// JustAoD constructs a valid AoD from a given slice of durations.
+func JustAoD(x []time.Duration) AoD {
+	return AoD{just: x}
+}
+
+// ErrAoD constructs an invalid AoD from a given error.
+func ErrAoD(e error) AoD {
+	return AoD{err: e}
+}
+
+// NothingAoD constructs an invalid AoD that holds nothing, for a missing rather
+// than a failed value.  See ErrNothing.
+func NothingAoD() AoD {
+	return AoD{err: ErrNothing}
+}
+
+// IsErr returns true for an invalid AoD.
+func (m AoD) IsErr() bool {
+	return m.just == nil || m.err != nil
+}
+
+// Bind applies a function that takes a slice of durations and returns an AoD.
+func (m AoD) Bind(f func(x []time.Duration) AoD) AoD {
+	if m.IsErr() {
+		return m
+	}
+
+	return try(f, m.just, ErrAoD)
+}
+
+// Join applies a function that takes a slice of durations and returns a D.
+func (m AoD) Join(f func(x []time.Duration) D) D {
+	if m.IsErr() {
+		return ErrD(zeroErr(m.err, "Join", "AoD"))
+	}
+
+	return try(f, m.just, ErrD)
+}
+
+// Split applies a splitting function to each element of a valid AoD,
+// resulting in a higher-dimension structure. If the AoD is invalid or if any
+// function returns an invalid AoD, Split returns an invalid AoAoD.
+func (m AoD) Split(f func(x time.Duration) AoD) AoAoD {
+	return AoAoD(SplitSlice(Slice[time.Duration](m).checkZero("Split", "AoD"), toSlice(f)))
+}
+
+// Map applies a function to each element of a valid AoD and returns a new
+// AoD.  If the AoD is invalid or if any function returns an invalid D, Map
+// returns an invalid AoD.
+func (m AoD) Map(f func(x time.Duration) D) AoD {
+	return AoD(MapSlice(Slice[time.Duration](m).checkZero("Map", "AoD"), toMaybe(f)))
+}
+
+// Filter returns an AoD of the elements of a valid AoD for which a function
+// returns true, in order.  If the AoD is invalid or if any function returns an
+// invalid B, Filter returns an invalid AoD.
+func (m AoD) Filter(f func(x time.Duration) B) AoD {
+	return AoD(Slice[time.Duration](m).checkZero("Filter", "AoD").Filter(toMaybe(f)))
+}
+
+// Reject is the opposite of Filter: it returns an AoD of the elements of a
+// valid AoD for which a function returns false.
+func (m AoD) Reject(f func(x time.Duration) B) AoD {
+	return AoD(Slice[time.Duration](m).checkZero("Reject", "AoD").Reject(toMaybe(f)))
+}
+
+// Partition splits a valid AoD into the elements for which a function returns
+// true and those for which it returns false.  If the AoD is invalid or if any
+// function returns an invalid B, both results are invalid.
+func (m AoD) Partition(f func(x time.Duration) B) (AoD, AoD) {
+	in, out := Slice[time.Duration](m).checkZero("Partition", "AoD").Partition(toMaybe(f))
+	return AoD(in), AoD(out)
+}
+
+// Format formats each element of a valid AoD as time.Duration.String does,
+// resulting in an AoS.  If the AoD is invalid, Format returns an invalid AoS.
+func (m AoD) Format() AoS {
+	return AoS(MapSlice(Slice[time.Duration](m).checkZero("Format", "AoD"), func(x time.Duration) Maybe[string] {
+		return Just(x.String())
+	}))
+}
+
+// Sum returns the total of the durations in a valid AoD, which is zero for
+// an empty AoD.  If the AoD is invalid, Sum returns an invalid D.  If the
+// total overflows time.Duration, Sum returns an invalid D whose error wraps
+// strconv.ErrRange, tagged with the index of the element that overflowed.
+func (m AoD) Sum() D {
+	return m.Join(func(xs []time.Duration) D {
+		var sum time.Duration
+		for i, v := range xs {
+			next := sum + v
+			if (v > 0 && next < sum) || (v < 0 && next > sum) {
+				return ErrD(elemErr(rangeErr("Sum", v.String()), i))
+			}
+			sum = next
+		}
+		return JustD(sum)
+	})
+}
+
+// IsNothing returns true for an AoD that holds nothing, i.e. one whose error is
+// ErrNothing itself.  Such an AoD is also invalid, so IsErr returns true.  An
+// error that only wraps ErrNothing, such as an ElementError for a missing
+// element, is an ordinary error.
+func (m AoD) IsNothing() bool {
+	return Slice[time.Duration](m).IsNothing()
+}
+
+// JustOr returns a valid AoD holding a default value if the AoD holds nothing,
+// or otherwise returns the AoD unchanged.  Unlike OrElse, it leaves other
+// errors in place.  A nil default is replaced by an empty one, as for OrElse.
+func (m AoD) JustOr(x []time.Duration) AoD {
+	return AoD(Slice[time.Duration](m).JustOr(x))
+}
+
+// OrElse returns the AoD if it is valid, or otherwise a valid AoD holding a
+// default value.  A nil default is replaced by an empty one, so the result is
+// always valid.
+func (m AoD) OrElse(x []time.Duration) AoD {
+	return AoD(Slice[time.Duration](m).OrElse(x))
+}
+
+// Recover returns the AoD if it is valid, or otherwise a valid AoD holding the
+// value returned by a function that takes the error, with nil replaced by an
+// empty value as for OrElse.
+func (m AoD) Recover(f func(err error) []time.Duration) AoD {
+	return AoD(Slice[time.Duration](m).checkZero("Unbox", "AoD").Recover(f))
+}
+
+// MapErr returns the AoD if it is valid, or otherwise an invalid AoD with the
+// error returned by a function that takes the original error, e.g. to add
+// context with fmt.Errorf and %w.  If the function returns nil, the original
+// error is kept.
+func (m AoD) MapErr(f func(err error) error) AoD {
+	return AoD(Slice[time.Duration](m).checkZero("Unbox", "AoD").MapErr(f))
+}
+
+// Catch is like Recover, but only for errors that match a target according
+// to errors.Is, and the function returns an AoD, so it may fail again.  Valid
+// values and other errors are returned unchanged.
+func (m AoD) Catch(target error, f func(err error) AoD) AoD {
+	return AoD(Slice[time.Duration](m).checkZero("Unbox", "AoD").Catch(target, toSlice(f)))
+}
+
+// MarshalJSON implements json.Marshaler using the wire format described in
+// the package documentation.
+func (m AoD) MarshalJSON() ([]byte, error) {
+	return marshalJSON(m.Unbox())
+}
+
+// UnmarshalJSON implements json.Unmarshaler using the wire format described
+// in the package documentation.
+func (m *AoD) UnmarshalJSON(b []byte) error {
+	return unmarshalJSON(b, (*Maybe[[]time.Duration])(m), "AoD")
+}
+
+// String returns a string representation, mostly useful for debugging.
+func (m AoD) String() string {
+	if m.IsErr() {
+		return fmt.Sprintf("Err %v", m.err)
+	}
+	return fmt.Sprintf("Just %v", m.just)
+}
+
+// Unbox returns the underlying slice of durations or error.
+func (m AoD) Unbox() ([]time.Duration, error) {
+	if m.just == nil && m.err == nil {
+		return nil, &OpError{Op: "Unbox", Type: "AoD", Err: ErrZeroValue}
+	}
+	return m.just, m.err
+}
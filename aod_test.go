@@ -0,0 +1,77 @@
+package maybe_test
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/xdg/maybe"
+	"github.com/xdg/testy"
+)
+
+func TestAoD(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	input := []time.Duration{time.Second, time.Minute}
+	good := maybe.JustAoD(input)
+	bad := maybe.ErrAoD(errors.New("bad durations"))
+
+	just, err := good.Unbox()
+	is.Equal(just, input)
+	is.Nil(err)
+	is.False(good.IsErr())
+
+	just, err = bad.Unbox()
+	is.Nil(just)
+	is.Equal(err.Error(), "bad durations")
+	is.True(bad.IsErr())
+
+	is.Equal(maybe.NewAoD(input, nil), good)
+	is.True(maybe.NewAoD(nil, err).IsErr())
+
+	is.Equal(good.String(), "Just [1s 1m0s]")
+	is.Equal(bad.String(), "Err bad durations")
+
+	zero := maybe.AoD{}
+	is.True(zero.IsErr())
+	_, err = zero.Unbox()
+	is.NotNil(err)
+}
+
+func TestAoDParseSum(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	ds := maybe.JustAoS([]string{"1m", "30s", "1h"}).ToDuration()
+	sum, err := ds.Sum().Unbox()
+	is.Equal(sum, time.Hour+90*time.Second)
+	is.Nil(err)
+
+	xs, err := ds.Format().Unbox()
+	is.Equal(xs, []string{"1m0s", "30s", "1h0m0s"})
+	is.Nil(err)
+
+	sum, err = maybe.JustAoD([]time.Duration{}).Sum().Unbox()
+	is.Equal(sum, time.Duration(0))
+	is.Nil(err)
+
+	// Overflow is reported rather than wrapping around
+	_, err = maybe.JustAoD([]time.Duration{time.Hour, math.MaxInt64, -time.Hour}).Sum().Unbox()
+	is.True(errors.Is(err, strconv.ErrRange))
+	is.Equal(err.(*maybe.ElementError).Index, []int{1})
+	_, err = maybe.JustAoD([]time.Duration{-time.Hour, math.MinInt64}).Sum().Unbox()
+	is.True(errors.Is(err, strconv.ErrRange))
+	sum, err = maybe.JustAoD([]time.Duration{math.MaxInt64, -time.Hour, time.Hour}).Sum().Unbox()
+	is.Equal(sum, time.Duration(math.MaxInt64))
+	is.Nil(err)
+
+	_, err = maybe.JustAoS([]string{"1m", "soon"}).ToDuration().Unbox()
+	is.Equal(err.Error(), `element [1]: time: invalid duration "soon"`)
+
+	bad := maybe.ErrAoD(errors.New("bad durations"))
+	is.True(bad.Sum().IsErr())
+	is.True(bad.Format().IsErr())
+}
@@ -0,0 +1,171 @@
+package maybe
+
+import "fmt"
+
+// AoF implements the Maybe monad for a slice of float64s.  An AoF is
+// considered 'valid' or 'invalid' depending on whether it contains a slice of
+// float64s or an error value.  A zero-value AoF is invalid and Unbox() will
+// return an error to that effect.
+type AoF Slice[float64]
+
+// NewAoF constructs an AoF from a given slice of float64s or error. If e is
+// not nil, returns ErrAoF(e), otherwise returns JustAoF(x).
+func NewAoF(x []float64, e error) AoF {
+	if e != nil {
+		return ErrAoF(e)
+	}
+	return JustAoF(x)
+}
+
+// JustAoF constructs a valid AoF from a given slice of float64s.
+func JustAoF(x []float64) AoF {
+	return AoF{just: x}
+}
+
+// ErrAoF constructs an invalid AoF from a given error.
+func ErrAoF(e error) AoF {
+	return AoF{err: e}
+}
+
+// NothingAoF constructs an invalid AoF that holds nothing, for a missing rather
+// than a failed value.  See ErrNothing.
+func NothingAoF() AoF {
+	return AoF{err: ErrNothing}
+}
+
+// IsErr returns true for an invalid AoF.
+func (m AoF) IsErr() bool {
+	return m.just == nil || m.err != nil
+}
+
+// Bind applies a function that takes a slice of float64s and returns an AoF.
+func (m AoF) Bind(f func(x []float64) AoF) AoF {
+	if m.IsErr() {
+		return m
+	}
+
+	return try(f, m.just, ErrAoF)
+}
+
+// Join applies a function that takes a slice of float64s and returns an F.
+func (m AoF) Join(f func(x []float64) F) F {
+	if m.IsErr() {
+		return ErrF(zeroErr(m.err, "Join", "AoF"))
+	}
+
+	return try(f, m.just, ErrF)
+}
+
+// Split applies a splitting function to each element of a valid AoF,
+// resulting in a higher-dimension structure. If the AoF is invalid or if any
+// function returns an invalid AoF, Split returns an invalid AoAoF.
+func (m AoF) Split(f func(x float64) AoF) AoAoF {
+	return AoAoF(SplitSlice(Slice[float64](m).checkZero("Split", "AoF"), toSlice(f)))
+}
+
+// Map applies a function to each element of a valid AoF and returns a new
+// AoF.  If the AoF is invalid or if any function returns an invalid F, Map
+// returns an invalid AoF.
+func (m AoF) Map(f func(x float64) F) AoF {
+	return AoF(MapSlice(Slice[float64](m).checkZero("Map", "AoF"), toMaybe(f)))
+}
+
+// Filter returns an AoF of the elements of a valid AoF for which a function
+// returns true, in order.  If the AoF is invalid or if any function returns an
+// invalid B, Filter returns an invalid AoF.
+func (m AoF) Filter(f func(x float64) B) AoF {
+	return AoF(Slice[float64](m).checkZero("Filter", "AoF").Filter(toMaybe(f)))
+}
+
+// Reject is the opposite of Filter: it returns an AoF of the elements of a
+// valid AoF for which a function returns false.
+func (m AoF) Reject(f func(x float64) B) AoF {
+	return AoF(Slice[float64](m).checkZero("Reject", "AoF").Reject(toMaybe(f)))
+}
+
+// Partition splits a valid AoF into the elements for which a function returns
+// true and those for which it returns false.  If the AoF is invalid or if any
+// function returns an invalid B, both results are invalid.
+func (m AoF) Partition(f func(x float64) B) (AoF, AoF) {
+	in, out := Slice[float64](m).checkZero("Partition", "AoF").Partition(toMaybe(f))
+	return AoF(in), AoF(out)
+}
+
+// ToStr applies a function that takes a float64 and returns an S.  If the AoF
+// is invalid or if any function returns an invalid S, ToStr returns an
+// invalid AoS.
+func (m AoF) ToStr(f func(x float64) S) AoS {
+	return AoS(MapSlice(Slice[float64](m).checkZero("ToStr", "AoF"), toMaybe(f)))
+}
+
+// IsNothing returns true for an AoF that holds nothing, i.e. one whose error is
+// ErrNothing itself.  Such an AoF is also invalid, so IsErr returns true.  An
+// error that only wraps ErrNothing, such as an ElementError for a missing
+// element, is an ordinary error.
+func (m AoF) IsNothing() bool {
+	return Slice[float64](m).IsNothing()
+}
+
+// JustOr returns a valid AoF holding a default value if the AoF holds nothing,
+// or otherwise returns the AoF unchanged.  Unlike OrElse, it leaves other
+// errors in place.  A nil default is replaced by an empty one, as for OrElse.
+func (m AoF) JustOr(x []float64) AoF {
+	return AoF(Slice[float64](m).JustOr(x))
+}
+
+// OrElse returns the AoF if it is valid, or otherwise a valid AoF holding a
+// default value.  A nil default is replaced by an empty one, so the result is
+// always valid.
+func (m AoF) OrElse(x []float64) AoF {
+	return AoF(Slice[float64](m).OrElse(x))
+}
+
+// Recover returns the AoF if it is valid, or otherwise a valid AoF holding the
+// value returned by a function that takes the error, with nil replaced by an
+// empty value as for OrElse.
+func (m AoF) Recover(f func(err error) []float64) AoF {
+	return AoF(Slice[float64](m).checkZero("Unbox", "AoF").Recover(f))
+}
+
+// MapErr returns the AoF if it is valid, or otherwise an invalid AoF with the
+// error returned by a function that takes the original error, e.g. to add
+// context with fmt.Errorf and %w.  If the function returns nil, the original
+// error is kept.
+func (m AoF) MapErr(f func(err error) error) AoF {
+	return AoF(Slice[float64](m).checkZero("Unbox", "AoF").MapErr(f))
+}
+
+// Catch is like Recover, but only for errors that match a target according
+// to errors.Is, and the function returns an AoF, so it may fail again.  Valid
+// values and other errors are returned unchanged.
+func (m AoF) Catch(target error, f func(err error) AoF) AoF {
+	return AoF(Slice[float64](m).checkZero("Unbox", "AoF").Catch(target, toSlice(f)))
+}
+
+// MarshalJSON implements json.Marshaler using the wire format described in
+// the package documentation.
+func (m AoF) MarshalJSON() ([]byte, error) {
+	return marshalJSON(m.Unbox())
+}
+
+// UnmarshalJSON implements json.Unmarshaler using the wire format described
+// in the package documentation.
+func (m *AoF) UnmarshalJSON(b []byte) error {
+	return unmarshalJSON(b, (*Maybe[[]float64])(m), "AoF")
+}
+
+// String returns a string representation, mostly useful for debugging.
+func (m AoF) String() string {
+	if m.IsErr() {
+		return fmt.Sprintf("Err %v", m.err)
+	}
+	return fmt.Sprintf("Just %v", m.just)
+}
+
+// Unbox returns the underlying slice of float64s or error.
+func (m AoF) Unbox() ([]float64, error) {
+	if m.just == nil && m.err == nil {
+		return nil, &OpError{Op: "Unbox", Type: "AoF", Err: ErrZeroValue}
+	}
+	return m.just, m.err
+}
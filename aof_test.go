@@ -0,0 +1,114 @@
+package maybe_test
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/xdg/maybe"
+	"github.com/xdg/testy"
+)
+
+func getFloatFixtures(input []float64) (good, bad maybe.AoF) {
+	good = maybe.JustAoF(input)
+	bad = maybe.ErrAoF(errors.New("bad float"))
+	return
+}
+
+func TestAoF(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	input := []float64{2.5, 4.2}
+	good, bad := getFloatFixtures(input)
+	var got maybe.AoF
+	var just []float64
+	var err error
+
+	just, err = good.Unbox()
+	is.Equal(just, input)
+	is.Nil(err)
+	is.False(good.IsErr())
+
+	just, err = bad.Unbox()
+	is.Nil(just)
+	is.NotNil(err)
+	is.Equal(err.Error(), "bad float")
+	is.True(bad.IsErr())
+
+	got = maybe.NewAoF(input, nil)
+	is.Equal(got, good)
+
+	got = maybe.NewAoF(nil, err)
+	is.True(got.IsErr())
+
+	is.Equal(good.String(), "Just [2.5 4.2]")
+	is.Equal(bad.String(), "Err bad float")
+
+	// Check zero value case
+	zero := maybe.AoF{}
+	is.True(zero.IsErr())
+	_, err = zero.Unbox()
+	is.NotNil(err)
+}
+
+func TestAoFOperations(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	good, bad := getFloatFixtures([]float64{2.5, 4.2})
+
+	tail := func(xs []float64) maybe.AoF { return maybe.JustAoF(xs[1:]) }
+	just, err := good.Bind(tail).Unbox()
+	is.Equal(just, []float64{4.2})
+	is.Nil(err)
+	is.True(bad.Bind(tail).IsErr())
+
+	sum := func(xs []float64) maybe.F {
+		var total float64
+		for _, v := range xs {
+			total += v
+		}
+		return maybe.JustF(total)
+	}
+	total, err := good.Join(sum).Unbox()
+	is.Equal(total, 6.7)
+	is.Nil(err)
+	is.True(bad.Join(sum).IsErr())
+
+	half := func(x float64) maybe.F { return maybe.JustF(x / 2) }
+	just, err = good.Map(half).Unbox()
+	is.Equal(just, []float64{1.25, 2.1})
+	is.Nil(err)
+	is.True(bad.Map(half).IsErr())
+	is.True(good.Map(func(x float64) maybe.F { return maybe.ErrF(errors.New("no")) }).IsErr())
+
+	pair := func(x float64) maybe.AoF { return maybe.JustAoF([]float64{x, -x}) }
+	pairs, err := good.Split(pair).Unbox()
+	is.Equal(pairs, [][]float64{{2.5, -2.5}, {4.2, -4.2}})
+	is.Nil(err)
+	is.True(bad.Split(pair).IsErr())
+}
+
+func TestAoFConversions(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	parse := func(s string) maybe.F { return maybe.NewF(strconv.ParseFloat(s, 64)) }
+	format := func(x float64) maybe.S { return maybe.JustS(strconv.FormatFloat(x, 'f', -1, 64)) }
+
+	just, err := maybe.JustAoS([]string{"2.5", "4.2"}).ToFloat(parse).Unbox()
+	is.Equal(just, []float64{2.5, 4.2})
+	is.Nil(err)
+	is.True(maybe.JustAoS([]string{"2.5", "x"}).ToFloat(parse).IsErr())
+
+	strs, err := maybe.JustAoF([]float64{2.5, 4.2}).ToStr(format).Unbox()
+	is.Equal(strs, []string{"2.5", "4.2"})
+	is.Nil(err)
+	is.True(maybe.ErrAoF(errors.New("bad float")).ToStr(format).IsErr())
+
+	toFloat := func(x int) maybe.F { return maybe.JustF(float64(x)) }
+	just, err = maybe.JustAoI([]int{1, 2}).ToFloat(toFloat).Unbox()
+	is.Equal(just, []float64{1, 2})
+	is.Nil(err)
+}
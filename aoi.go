@@ -1,7 +1,7 @@
 package maybe
 
 import (
-	"errors"
+	"context"
 	"fmt"
 )
 
@@ -9,10 +9,7 @@ import (
 // 'valid' or 'invalid' depending on whether it contains a slice of ints or an
 // error value.  A zero-value AoI is invalid and Unbox() will return an error
 // to that effect.
-type AoI struct {
-	just []int
-	err  error
-}
+type AoI Slice[int]
 
 // NewAoI constructs an AoI from a given slice of ints or error. If e is not
 // nil, returns ErrAoI(e), otherwise returns JustAoI(s).
@@ -33,6 +30,19 @@ func ErrAoI(e error) AoI {
 	return AoI{err: e}
 }
 
+// NothingAoI constructs an invalid AoI that holds nothing, for a missing rather
+// than a failed value.  See ErrNothing.
+func NothingAoI() AoI {
+	return AoI{err: ErrNothing}
+}
+
+// SequenceI turns a slice of I into an AoI of their values.  If any element
+// is invalid, SequenceI returns an invalid AoI with the error of the first
+// one, tagged with its index.  Use SequenceAll to get all the errors.
+func SequenceI(xs []I) AoI {
+	return AoI(Sequence(xs))
+}
+
 // IsErr returns true for an invalid AoI.
 func (m AoI) IsErr() bool {
 	return m.just == nil || m.err != nil
@@ -44,56 +54,210 @@ func (m AoI) Bind(f func(s []int) AoI) AoI {
 		return m
 	}
 
-	return f(m.just)
+	return try(f, m.just, ErrAoI)
+}
+
+// BindCtx is like Bind, but the function also takes a context.  If the
+// context is done, BindCtx returns an invalid AoI with the context's error
+// instead of calling the function.
+func (m AoI) BindCtx(ctx context.Context, f func(ctx context.Context, s []int) AoI) AoI {
+	if m.IsErr() {
+		return m
+	}
+	if err := ctx.Err(); err != nil {
+		return ErrAoI(err)
+	}
+
+	return tryCtx(ctx, f, m.just, ErrAoI)
 }
 
 // Join applies a function that takes a slice of ints and returns an I.
 func (m AoI) Join(f func(s []int) I) I {
 	if m.IsErr() {
-		return ErrI(m.err)
+		return ErrI(zeroErr(m.err, "Join", "AoI"))
+	}
+
+	return try(f, m.just, ErrI)
+}
+
+// JoinCtx is like Join, but the function also takes a context.  If the
+// context is done, JoinCtx returns an invalid I with the context's error
+// instead of calling the function.
+func (m AoI) JoinCtx(ctx context.Context, f func(ctx context.Context, s []int) I) I {
+	if m.IsErr() {
+		return ErrI(zeroErr(m.err, "JoinCtx", "AoI"))
+	}
+	if err := ctx.Err(); err != nil {
+		return ErrI(err)
 	}
 
-	return f(m.just)
+	return tryCtx(ctx, f, m.just, ErrI)
 }
 
 // Split applies a splitting function to each element of a valid AoI,
 // resulting in a higher-dimension structure. If the AoI is invalid or if any
 // function returns an invalid AoI, Split returns an invalid AoAoI.
 func (m AoI) Split(f func(s int) AoI) AoAoI {
-	if m.IsErr() {
-		return ErrAoAoI(m.err)
-	}
-
-	xss := make([][]int, len(m.just))
-	for i, v := range m.just {
-		xs, err := f(v).Unbox()
-		if err != nil {
-			return ErrAoAoI(err)
-		}
-		xss[i] = xs
-	}
+	return AoAoI(SplitSlice(Slice[int](m).checkZero("Split", "AoI"), toSlice(f)))
+}
 
-	return JustAoAoI(xss)
+// SplitCtx is like Split, but the function also takes a context.  The
+// context is checked before each element; once it is done, SplitCtx stops and
+// returns an invalid AoAoI with the context's error.
+func (m AoI) SplitCtx(ctx context.Context, f func(ctx context.Context, s int) AoI) AoAoI {
+	return AoAoI(SplitSliceCtx(ctx, Slice[int](m).checkZero("SplitCtx", "AoI"), toSliceCtx(f)))
 }
 
 // Map applies a function to each element of a valid AoI and returns a new
 // AoI.  If the AoI is invalid or if any function returns an invalid I, Map
 // returns an invalid AoI.
 func (m AoI) Map(f func(s int) I) AoI {
-	if m.IsErr() {
-		return m
-	}
+	return AoI(MapSlice(Slice[int](m).checkZero("Map", "AoI"), toMaybe(f)))
+}
 
-	xss := make([]int, len(m.just))
-	for i, v := range m.just {
-		x, err := f(v).Unbox()
-		if err != nil {
-			return ErrAoI(err)
-		}
-		xss[i] = x
-	}
+// Filter returns an AoI of the elements of a valid AoI for which a function
+// returns true, in order.  If the AoI is invalid or if any function returns an
+// invalid B, Filter returns an invalid AoI.
+func (m AoI) Filter(f func(s int) B) AoI {
+	return AoI(Slice[int](m).checkZero("Filter", "AoI").Filter(toMaybe(f)))
+}
+
+// Reject is the opposite of Filter: it returns an AoI of the elements of a
+// valid AoI for which a function returns false.
+func (m AoI) Reject(f func(s int) B) AoI {
+	return AoI(Slice[int](m).checkZero("Reject", "AoI").Reject(toMaybe(f)))
+}
+
+// Partition splits a valid AoI into the elements for which a function returns
+// true and those for which it returns false.  If the AoI is invalid or if any
+// function returns an invalid B, both results are invalid.
+func (m AoI) Partition(f func(s int) B) (AoI, AoI) {
+	in, out := Slice[int](m).checkZero("Partition", "AoI").Partition(toMaybe(f))
+	return AoI(in), AoI(out)
+}
+
+// Fold combines the elements of a valid AoI into a single I, starting from an
+// initial value and applying a function to the accumulator and each element
+// in turn.  An empty AoI results in the initial value.  If the AoI is invalid
+// or if any function returns an invalid I, Fold stops and returns an invalid
+// I.
+func (m AoI) Fold(init int, f func(acc int, s int) I) I {
+	return I(FoldSlice(Slice[int](m).checkZero("Fold", "AoI"), init, toMaybe2(f)))
+}
+
+// Scan is like Fold, but returns an AoI of the accumulator after each
+// element, e.g. running totals.  If the AoI is invalid or if any function
+// returns an invalid I, Scan returns an invalid AoI.
+func (m AoI) Scan(init int, f func(acc int, s int) I) AoI {
+	return AoI(ScanSlice(Slice[int](m).checkZero("Scan", "AoI"), init, toMaybe2(f)))
+}
+
+// Zip pairs up the elements of two valid AoIs of the same length, resulting
+// in an AoAoI with a row of two elements for each pair.  If either AoI is
+// invalid, Zip returns an invalid AoAoI with the error of the first invalid
+// one; if their lengths differ, the error wraps ErrLengthMismatch.
+func (m AoI) Zip(other AoI) AoAoI {
+	return AoAoI(Slice[int](m).checkZero("Zip", "AoI").Zip(Slice[int](other).checkZero("Zip", "AoI")))
+}
+
+// ZipWith applies a function to each pair of elements of two valid AoIs of
+// the same length and returns a new AoI.  It fails as Zip does, or if any
+// function returns an invalid I.
+func (m AoI) ZipWith(other AoI, f func(x, y int) I) AoI {
+	return AoI(ZipSliceWith(Slice[int](m).checkZero("ZipWith", "AoI"), Slice[int](other).checkZero("ZipWith", "AoI"), toMaybe2(f)))
+}
+
+// MapCtx is like Map, but the function also takes a context.  The context is
+// checked before each element; once it is done, MapCtx stops and returns an
+// invalid AoI with the context's error.
+func (m AoI) MapCtx(ctx context.Context, f func(ctx context.Context, s int) I) AoI {
+	return AoI(MapSliceCtx(ctx, Slice[int](m).checkZero("MapCtx", "AoI"), toMaybeCtx(f)))
+}
+
+// MapAll is like Map, but applies the function to every element even after
+// a failure.  If any function returns an invalid I, MapAll returns an
+// invalid AoI whose error joins the errors from all failing elements, each
+// tagged with its index.
+func (m AoI) MapAll(f func(s int) I) AoI {
+	return AoI(MapSliceAll(Slice[int](m).checkZero("MapAll", "AoI"), toMaybe(f)))
+}
+
+// MapResults applies a function to each element of a valid AoI and keeps the
+// outcome for every element, so that the valid results can be used and the
+// failures reported.  If the AoI is invalid, MapResults returns an invalid
+// Results.
+func (m AoI) MapResults(f func(s int) I) Results[int] {
+	return MapResults(Slice[int](m).checkZero("MapResults", "AoI"), toMaybe(f))
+}
+
+// ParallelMap is like Map, but runs the function on up to n elements
+// concurrently, or GOMAXPROCS elements if n is less than 1.  The order of
+// elements is preserved.  Once a function returns an invalid I, no further
+// elements are started and ParallelMap returns an invalid AoI.
+func (m AoI) ParallelMap(n int, f func(s int) I) AoI {
+	return AoI(ParallelMapSlice(Slice[int](m).checkZero("ParallelMap", "AoI"), n, toMaybe(f)))
+}
+
+// Seq returns a lazy sequence of the elements of the AoI.  If the AoI is
+// invalid, the sequence yields only its error.
+func (m AoI) Seq() Seq[int] {
+	return Slice[int](m).checkZero("Seq", "AoI").Seq()
+}
+
+// IsNothing returns true for an AoI that holds nothing, i.e. one whose error is
+// ErrNothing itself.  Such an AoI is also invalid, so IsErr returns true.  An
+// error that only wraps ErrNothing, such as an ElementError for a missing
+// element, is an ordinary error.
+func (m AoI) IsNothing() bool {
+	return Slice[int](m).IsNothing()
+}
+
+// JustOr returns a valid AoI holding a default value if the AoI holds nothing,
+// or otherwise returns the AoI unchanged.  Unlike OrElse, it leaves other
+// errors in place.  A nil default is replaced by an empty one, as for OrElse.
+func (m AoI) JustOr(x []int) AoI {
+	return AoI(Slice[int](m).JustOr(x))
+}
+
+// OrElse returns the AoI if it is valid, or otherwise a valid AoI holding a
+// default value.  A nil default is replaced by an empty one, so the result is
+// always valid.
+func (m AoI) OrElse(x []int) AoI {
+	return AoI(Slice[int](m).OrElse(x))
+}
+
+// Recover returns the AoI if it is valid, or otherwise a valid AoI holding the
+// value returned by a function that takes the error, with nil replaced by an
+// empty value as for OrElse.
+func (m AoI) Recover(f func(err error) []int) AoI {
+	return AoI(Slice[int](m).checkZero("Unbox", "AoI").Recover(f))
+}
+
+// MapErr returns the AoI if it is valid, or otherwise an invalid AoI with the
+// error returned by a function that takes the original error, e.g. to add
+// context with fmt.Errorf and %w.  If the function returns nil, the original
+// error is kept.
+func (m AoI) MapErr(f func(err error) error) AoI {
+	return AoI(Slice[int](m).checkZero("Unbox", "AoI").MapErr(f))
+}
+
+// Catch is like Recover, but only for errors that match a target according
+// to errors.Is, and the function returns an AoI, so it may fail again.  Valid
+// values and other errors are returned unchanged.
+func (m AoI) Catch(target error, f func(err error) AoI) AoI {
+	return AoI(Slice[int](m).checkZero("Unbox", "AoI").Catch(target, toSlice(f)))
+}
 
-	return JustAoI(xss)
+// MarshalJSON implements json.Marshaler using the wire format described in
+// the package documentation.
+func (m AoI) MarshalJSON() ([]byte, error) {
+	return marshalJSON(m.Unbox())
+}
+
+// UnmarshalJSON implements json.Unmarshaler using the wire format described
+// in the package documentation.
+func (m *AoI) UnmarshalJSON(b []byte) error {
+	return unmarshalJSON(b, (*Maybe[[]int])(m), "AoI")
 }
 
 // String returns a string representation, mostly useful for debugging.
@@ -108,26 +272,73 @@ func (m AoI) String() string {
 // is invalid or if any function returns an invalid S, ToStr returns an
 // invalid AoS.
 func (m AoI) ToStr(f func(x int) S) AoS {
-	if m.IsErr() {
-		return ErrAoS(m.err)
-	}
+	return AoS(MapSlice(Slice[int](m).checkZero("ToStr", "AoI"), toMaybe(f)))
+}
 
-	xss := make([]string, len(m.just))
-	for i, v := range m.just {
-		str, err := f(v).Unbox()
-		if err != nil {
-			return ErrAoS(err)
-		}
-		xss[i] = str
-	}
+// ToFloat applies a function that takes an int and returns an F.  If the
+// AoI is invalid or if any function returns an invalid F, ToFloat returns an
+// invalid AoF.
+func (m AoI) ToFloat(f func(x int) F) AoF {
+	return AoF(MapSlice(Slice[int](m).checkZero("ToFloat", "AoI"), toMaybe(f)))
+}
+
+// ToBool applies a function that takes an int and returns a B, such as a
+// predicate, to each element.  If the AoI is invalid or if any function
+// returns an invalid B, ToBool returns an invalid AoB.
+func (m AoI) ToBool(f func(x int) B) AoB {
+	return AoB(MapSlice(Slice[int](m).checkZero("ToBool", "AoI"), toMaybe(f)))
+}
+
+// ToI64 converts each element of a valid AoI to int64, resulting in an AoI64.
+// If the AoI is invalid, ToI64 returns an invalid AoI64.
+func (m AoI) ToI64() AoI64 {
+	return AoI64(MapSlice(Slice[int](m).checkZero("ToI64", "AoI"), intToI64))
+}
+
+// ToU64 converts each element of a valid AoI to uint64, resulting in an AoU64.
+// If the AoI is invalid or any element is out of range, ToU64 returns an
+// invalid AoU64.
+func (m AoI) ToU64() AoU64 {
+	return AoU64(MapSlice(Slice[int](m).checkZero("ToU64", "AoI"), intToU64))
+}
+
+// ToBigI converts each element of a valid AoI to *big.Int, resulting in an
+// AoBigI.  If the AoI is invalid, ToBigI returns an invalid AoBigI.
+func (m AoI) ToBigI() AoBigI {
+	return AoBigI(MapSlice(Slice[int](m).checkZero("ToBigI", "AoI"), intToBigI))
+}
+
+// ToStrCtx is like ToStr, but the function also takes a context.  The
+// context is checked before each element; once it is done, ToStrCtx stops and
+// returns an invalid AoS with the context's error.
+func (m AoI) ToStrCtx(ctx context.Context, f func(ctx context.Context, x int) S) AoS {
+	return AoS(MapSliceCtx(ctx, Slice[int](m).checkZero("ToStrCtx", "AoI"), toMaybeCtx(f)))
+}
+
+// ToStrAll is like ToStr, but applies the function to every element even
+// after a failure.  If any function returns an invalid S, ToStrAll returns an
+// invalid AoS whose error joins the errors from all failing elements, each
+// tagged with its index.
+func (m AoI) ToStrAll(f func(x int) S) AoS {
+	return AoS(MapSliceAll(Slice[int](m).checkZero("ToStrAll", "AoI"), toMaybe(f)))
+}
+
+// ToStrResults is like ToStr, but keeps the outcome for every element as
+// MapResults does.
+func (m AoI) ToStrResults(f func(x int) S) Results[string] {
+	return MapResults(Slice[int](m).checkZero("ToStrResults", "AoI"), toMaybe(f))
+}
 
-	return JustAoS(xss)
+// ParallelToStr is like ToStr, but runs the function on up to n elements
+// concurrently, with the same ordering and error semantics as ParallelMap.
+func (m AoI) ParallelToStr(n int, f func(x int) S) AoS {
+	return AoS(ParallelMapSlice(Slice[int](m).checkZero("ParallelToStr", "AoI"), n, toMaybe(f)))
 }
 
 // Unbox returns the underlying slice of ints or error.
 func (m AoI) Unbox() ([]int, error) {
 	if m.just == nil && m.err == nil {
-		return nil, errors.New("zero-value AoI")
+		return nil, &OpError{Op: "Unbox", Type: "AoI", Err: ErrZeroValue}
 	}
 	return m.just, m.err
 }
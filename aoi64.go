@@ -0,0 +1,185 @@
+package maybe
+
+import "fmt"
+
+// AoI64 implements the Maybe monad for a slice of int64s.  An AoI64 is
+// considered 'valid' or 'invalid' depending on whether it contains a slice of
+// int64s or an error value.  A zero-value AoI64 is invalid and Unbox() will
+// return an error to that effect.
+type AoI64 Slice[int64]
+
+// NewAoI64 constructs an AoI64 from a given slice of int64s or error. If e is
+// not nil, returns ErrAoI64(e), otherwise returns JustAoI64(x).
+func NewAoI64(x []int64, e error) AoI64 {
+	if e != nil {
+		return ErrAoI64(e)
+	}
+	return JustAoI64(x)
+}
+
+// JustAoI64 constructs a valid AoI64 from a given slice of int64s.
+func JustAoI64(x []int64) AoI64 {
+	return AoI64{just: x}
+}
+
+// ErrAoI64 constructs an invalid AoI64 from a given error.
+func ErrAoI64(e error) AoI64 {
+	return AoI64{err: e}
+}
+
+// NothingAoI64 constructs an invalid AoI64 that holds nothing, for a missing
+// rather than a failed value.  See ErrNothing.
+func NothingAoI64() AoI64 {
+	return AoI64{err: ErrNothing}
+}
+
+// IsErr returns true for an invalid AoI64.
+func (m AoI64) IsErr() bool {
+	return m.just == nil || m.err != nil
+}
+
+// Bind applies a function that takes a slice of int64s and returns an AoI64.
+func (m AoI64) Bind(f func(x []int64) AoI64) AoI64 {
+	if m.IsErr() {
+		return m
+	}
+
+	return try(f, m.just, ErrAoI64)
+}
+
+// Join applies a function that takes a slice of int64s and returns an I64.
+func (m AoI64) Join(f func(x []int64) I64) I64 {
+	if m.IsErr() {
+		return ErrI64(zeroErr(m.err, "Join", "AoI64"))
+	}
+
+	return try(f, m.just, ErrI64)
+}
+
+// Split applies a splitting function to each element of a valid AoI64,
+// resulting in a higher-dimension structure. If the AoI64 is invalid or if any
+// function returns an invalid AoI64, Split returns an invalid AoAoI64.
+func (m AoI64) Split(f func(x int64) AoI64) AoAoI64 {
+	return AoAoI64(SplitSlice(Slice[int64](m).checkZero("Split", "AoI64"), toSlice(f)))
+}
+
+// Map applies a function to each element of a valid AoI64 and returns a new
+// AoI64.  If the AoI64 is invalid or if any function returns an invalid I64,
+// Map returns an invalid AoI64.
+func (m AoI64) Map(f func(x int64) I64) AoI64 {
+	return AoI64(MapSlice(Slice[int64](m).checkZero("Map", "AoI64"), toMaybe(f)))
+}
+
+// Filter returns an AoI64 of the elements of a valid AoI64 for which a function
+// returns true, in order.  If the AoI64 is invalid or if any function returns
+// an invalid B, Filter returns an invalid AoI64.
+func (m AoI64) Filter(f func(x int64) B) AoI64 {
+	return AoI64(Slice[int64](m).checkZero("Filter", "AoI64").Filter(toMaybe(f)))
+}
+
+// Reject is the opposite of Filter: it returns an AoI64 of the elements of a
+// valid AoI64 for which a function returns false.
+func (m AoI64) Reject(f func(x int64) B) AoI64 {
+	return AoI64(Slice[int64](m).checkZero("Reject", "AoI64").Reject(toMaybe(f)))
+}
+
+// Partition splits a valid AoI64 into the elements for which a function returns
+// true and those for which it returns false.  If the AoI64 is invalid or if any
+// function returns an invalid B, both results are invalid.
+func (m AoI64) Partition(f func(x int64) B) (AoI64, AoI64) {
+	in, out := Slice[int64](m).checkZero("Partition", "AoI64").Partition(toMaybe(f))
+	return AoI64(in), AoI64(out)
+}
+
+// ToI converts each element of a valid AoI64 to int, resulting in an AoI.  If
+// the AoI64 is invalid or any element is out of range, ToI returns an
+// invalid AoI.
+func (m AoI64) ToI() AoI {
+	return AoI(MapSlice(Slice[int64](m).checkZero("ToI", "AoI64"), i64ToI))
+}
+
+// ToU64 converts each element of a valid AoI64 to uint64, resulting in an
+// AoU64.  If the AoI64 is invalid or any element is out of range, ToU64
+// returns an invalid AoU64.
+func (m AoI64) ToU64() AoU64 {
+	return AoU64(MapSlice(Slice[int64](m).checkZero("ToU64", "AoI64"), i64ToU64))
+}
+
+// ToBigI converts each element of a valid AoI64 to *big.Int, resulting in an
+// AoBigI.  If the AoI64 is invalid, ToBigI returns an invalid AoBigI.
+func (m AoI64) ToBigI() AoBigI {
+	return AoBigI(MapSlice(Slice[int64](m).checkZero("ToBigI", "AoI64"), i64ToBigI))
+}
+
+// IsNothing returns true for an AoI64 that holds nothing, i.e. one whose error
+// is ErrNothing itself.  Such an AoI64 is also invalid, so IsErr returns true.
+// An error that only wraps ErrNothing, such as an ElementError for a missing
+// element, is an ordinary error.
+func (m AoI64) IsNothing() bool {
+	return Slice[int64](m).IsNothing()
+}
+
+// JustOr returns a valid AoI64 holding a default value if the AoI64 holds
+// nothing, or otherwise returns the AoI64 unchanged.  Unlike OrElse, it leaves
+// other errors in place.  A nil default is replaced by an empty one, as for
+// OrElse.
+func (m AoI64) JustOr(x []int64) AoI64 {
+	return AoI64(Slice[int64](m).JustOr(x))
+}
+
+// OrElse returns the AoI64 if it is valid, or otherwise a valid AoI64 holding a
+// default value.  A nil default is replaced by an empty one, so the result is
+// always valid.
+func (m AoI64) OrElse(x []int64) AoI64 {
+	return AoI64(Slice[int64](m).OrElse(x))
+}
+
+// Recover returns the AoI64 if it is valid, or otherwise a valid AoI64 holding
+// the value returned by a function that takes the error, with nil replaced by
+// an empty value as for OrElse.
+func (m AoI64) Recover(f func(err error) []int64) AoI64 {
+	return AoI64(Slice[int64](m).checkZero("Unbox", "AoI64").Recover(f))
+}
+
+// MapErr returns the AoI64 if it is valid, or otherwise an invalid AoI64 with
+// the error returned by a function that takes the original error, e.g. to add
+// context with fmt.Errorf and %w.  If the function returns nil, the original
+// error is kept.
+func (m AoI64) MapErr(f func(err error) error) AoI64 {
+	return AoI64(Slice[int64](m).checkZero("Unbox", "AoI64").MapErr(f))
+}
+
+// Catch is like Recover, but only for errors that match a target according
+// to errors.Is, and the function returns an AoI64, so it may fail again.  Valid
+// values and other errors are returned unchanged.
+func (m AoI64) Catch(target error, f func(err error) AoI64) AoI64 {
+	return AoI64(Slice[int64](m).checkZero("Unbox", "AoI64").Catch(target, toSlice(f)))
+}
+
+// MarshalJSON implements json.Marshaler using the wire format described in
+// the package documentation.
+func (m AoI64) MarshalJSON() ([]byte, error) {
+	return marshalJSON(m.Unbox())
+}
+
+// UnmarshalJSON implements json.Unmarshaler using the wire format described
+// in the package documentation.
+func (m *AoI64) UnmarshalJSON(b []byte) error {
+	return unmarshalJSON(b, (*Maybe[[]int64])(m), "AoI64")
+}
+
+// String returns a string representation, mostly useful for debugging.
+func (m AoI64) String() string {
+	if m.IsErr() {
+		return fmt.Sprintf("Err %v", m.err)
+	}
+	return fmt.Sprintf("Just %v", m.just)
+}
+
+// Unbox returns the underlying slice of int64s or error.
+func (m AoI64) Unbox() ([]int64, error) {
+	if m.just == nil && m.err == nil {
+		return nil, &OpError{Op: "Unbox", Type: "AoI64", Err: ErrZeroValue}
+	}
+	return m.just, m.err
+}
@@ -0,0 +1,73 @@
+package maybe_test
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/xdg/maybe"
+	"github.com/xdg/testy"
+)
+
+func TestAoI64(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	input := []int64{1, -2}
+	good := maybe.JustAoI64(input)
+	bad := maybe.ErrAoI64(errors.New("bad int64s"))
+
+	just, err := good.Unbox()
+	is.Equal(just, input)
+	is.Nil(err)
+	is.False(good.IsErr())
+
+	just, err = bad.Unbox()
+	is.Nil(just)
+	is.Equal(err.Error(), "bad int64s")
+	is.True(bad.IsErr())
+
+	is.Equal(maybe.NewAoI64(input, nil), good)
+	is.True(maybe.NewAoI64(nil, err).IsErr())
+	is.Equal(good.String(), "Just [1 -2]")
+	is.Equal(bad.String(), "Err bad int64s")
+
+	zero := maybe.AoI64{}
+	is.True(zero.IsErr())
+	_, err = zero.Unbox()
+	is.NotNil(err)
+}
+
+func TestAoI64Conversions(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	good := maybe.JustAoS([]string{"1", "-2", "9000000000"}).ToI64()
+	xs, err := good.Unbox()
+	is.Equal(xs, []int64{1, -2, 9000000000})
+	is.Nil(err)
+
+	_, err = maybe.JustAoS([]string{"1", "99999999999999999999"}).ToI64().Unbox()
+	var ee *maybe.ElementError
+	is.True(errors.As(err, &ee))
+	is.Equal(ee.Index, []int{1})
+	is.True(errors.Is(err, strconv.ErrRange))
+
+	xs, err = maybe.JustAoI([]int{3, -4}).ToI64().Unbox()
+	is.Equal(xs, []int64{3, -4})
+	is.Nil(err)
+
+	_, err = good.ToU64().Unbox()
+	is.Equal(err.Error(), `element [1]: strconv.ToU64: parsing "-2": value out of range`)
+
+	bs, err := good.ToBigI().Unbox()
+	is.Equal(len(bs), 3)
+	is.Equal(bs[2].Int64(), int64(9000000000))
+	is.Nil(err)
+
+	bad := maybe.ErrAoI64(errors.New("bad int64s"))
+	is.True(bad.ToI().IsErr())
+	is.True(bad.ToU64().IsErr())
+	is.True(bad.ToBigI().IsErr())
+	is.True(maybe.ErrAoS(errors.New("bad strings")).ToI64().IsErr())
+}
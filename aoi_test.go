@@ -192,3 +192,122 @@ func TestAoIToString(t *testing.T) {
 	got = good.ToStr(func(x int) maybe.S { return maybe.ErrS(errors.New("invalid")) })
 	is.True(got.IsErr())
 }
+
+func TestAoIFilter(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	positive := func(x int) maybe.B { return maybe.JustB(x > 0) }
+	good := maybe.JustAoI([]int{3, -1, 0, 7})
+
+	xs, err := good.Filter(positive).Unbox()
+	is.Equal(xs, []int{3, 7})
+	is.Nil(err)
+
+	xs, err = good.Reject(positive).Unbox()
+	is.Equal(xs, []int{-1, 0})
+	is.Nil(err)
+
+	pos, rest := good.Partition(positive)
+	is.Equal(pos, maybe.JustAoI([]int{3, 7}))
+	is.Equal(rest, maybe.JustAoI([]int{-1, 0}))
+
+	bad := maybe.ErrAoI(errors.New("bad ints"))
+	is.True(bad.Filter(positive).IsErr())
+	is.True(bad.Reject(positive).IsErr())
+	pos, rest = bad.Partition(positive)
+	is.True(pos.IsErr())
+	is.True(rest.IsErr())
+}
+
+func TestAoIFold(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	mul := func(acc, x int) maybe.I {
+		if x == 0 {
+			return maybe.ErrI(errors.New("zero factor"))
+		}
+		return maybe.JustI(acc * x)
+	}
+	good := maybe.JustAoI([]int{1, 2, 3, 4})
+
+	is.Equal(good.Fold(1, mul), maybe.JustI(24))
+	is.Equal(good.Scan(1, mul), maybe.JustAoI([]int{1, 2, 6, 24}))
+
+	_, err := maybe.JustAoI([]int{2, 0}).Fold(1, mul).Unbox()
+	is.Equal(err.Error(), "element [1]: zero factor")
+	is.True(maybe.JustAoI([]int{2, 0}).Scan(1, mul).IsErr())
+	is.True(maybe.ErrAoI(errors.New("bad ints")).Fold(1, mul).IsErr())
+}
+
+func TestAoIZip(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	xs := maybe.JustAoI([]int{1, 2, 3})
+	ys := maybe.JustAoI([]int{4, 5, 6})
+
+	pairs := xs.Zip(ys)
+	is.Equal(pairs, maybe.JustAoAoI([][]int{{1, 4}, {2, 5}, {3, 6}}))
+	a, b := pairs.Unzip()
+	is.Equal(a, xs)
+	is.Equal(b, ys)
+
+	div := func(x, y int) maybe.I {
+		if y == 0 {
+			return maybe.ErrI(errors.New("divide by zero"))
+		}
+		return maybe.JustI(x / y)
+	}
+	is.Equal(ys.ZipWith(xs, div), maybe.JustAoI([]int{4, 2, 2}))
+	_, err := xs.ZipWith(maybe.JustAoI([]int{1, 0, 1}), div).Unbox()
+	is.Equal(err.Error(), "element [1]: divide by zero")
+
+	_, err = xs.Zip(maybe.JustAoI([]int{1})).Unbox()
+	is.True(errors.Is(err, maybe.ErrLengthMismatch))
+	_, err = xs.ZipWith(maybe.ErrAoI(errors.New("bad ints")), div).Unbox()
+	is.Equal(err.Error(), "bad ints")
+}
+
+func TestSequenceI(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	is.Equal(maybe.SequenceI([]maybe.I{maybe.JustI(3), maybe.JustI(4)}), maybe.JustAoI([]int{3, 4}))
+
+	bad := []maybe.I{maybe.ErrI(errors.New("first")), maybe.ErrI(errors.New("second"))}
+	_, err := maybe.SequenceI(bad).Unbox()
+	is.Equal(err.Error(), "element [0]: first")
+	_, err = maybe.AoI(maybe.SequenceAll(bad)).Unbox()
+	is.Equal(err.Error(), "element [0]: first\nelement [1]: second")
+}
+
+func TestAoINothing(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	n := maybe.NothingAoI()
+	is.True(n.IsNothing())
+	is.Equal(n.JustOr([]int{}), maybe.JustAoI([]int{}))
+	is.True(n.Map(func(x int) maybe.I { return maybe.JustI(x) }).IsNothing())
+	is.True(n.Join(func(xs []int) maybe.I { return maybe.JustI(len(xs)) }).IsNothing())
+
+	// Nothing from one element is an ordinary element error
+	positive := func(x int) maybe.I {
+		if x < 0 {
+			return maybe.ErrI(errors.New("negative"))
+		}
+		if x == 0 {
+			return maybe.NothingI()
+		}
+		return maybe.JustI(x)
+	}
+	got := maybe.JustAoI([]int{1, 0}).Map(positive)
+	is.False(got.IsNothing())
+	is.Equal(got.JustOr([]int{}), got)
+	_, err := got.Unbox()
+	is.True(errors.Is(err, maybe.ErrNothing))
+	is.False(maybe.JustAoI([]int{1, -1}).Map(positive).IsNothing())
+	is.False(maybe.AoI{}.IsNothing())
+}
@@ -0,0 +1,175 @@
+package maybe
+
+import "fmt"
+
+// AoR implements the Maybe monad for a slice of runes.  An AoR is
+// considered 'valid' or 'invalid' depending on whether it contains a slice of
+// runes or an error value.  A zero-value AoR is invalid and Unbox() will
+// return an error to that effect.
+type AoR Slice[rune]
+
+// NewAoR constructs an AoR from a given slice of runes or error. If e is
+// not nil, returns ErrAoR(e), otherwise returns JustAoR(x).
+func NewAoR(x []rune, e error) AoR {
+	if e != nil {
+		return ErrAoR(e)
+	}
+	return JustAoR(x)
+}
+
+// JustAoR constructs a valid AoR from a given slice of runes.
+func JustAoR(x []rune) AoR {
+	return AoR{just: x}
+}
+
+// ErrAoR constructs an invalid AoR from a given error.
+func ErrAoR(e error) AoR {
+	return AoR{err: e}
+}
+
+// NothingAoR constructs an invalid AoR that holds nothing, for a missing rather
+// than a failed value.  See ErrNothing.
+func NothingAoR() AoR {
+	return AoR{err: ErrNothing}
+}
+
+// IsErr returns true for an invalid AoR.
+func (m AoR) IsErr() bool {
+	return m.just == nil || m.err != nil
+}
+
+// Bind applies a function that takes a slice of runes and returns an AoR.
+func (m AoR) Bind(f func(x []rune) AoR) AoR {
+	if m.IsErr() {
+		return m
+	}
+
+	return try(f, m.just, ErrAoR)
+}
+
+// Join applies a function that takes a slice of runes and returns an R.
+func (m AoR) Join(f func(x []rune) R) R {
+	if m.IsErr() {
+		return ErrR(zeroErr(m.err, "Join", "AoR"))
+	}
+
+	return try(f, m.just, ErrR)
+}
+
+// Split applies a splitting function to each element of a valid AoR,
+// resulting in a higher-dimension structure. If the AoR is invalid or if any
+// function returns an invalid AoR, Split returns an invalid AoAoR.
+func (m AoR) Split(f func(x rune) AoR) AoAoR {
+	return AoAoR(SplitSlice(Slice[rune](m).checkZero("Split", "AoR"), toSlice(f)))
+}
+
+// Map applies a function to each element of a valid AoR and returns a new
+// AoR.  If the AoR is invalid or if any function returns an invalid R, Map
+// returns an invalid AoR.
+func (m AoR) Map(f func(x rune) R) AoR {
+	return AoR(MapSlice(Slice[rune](m).checkZero("Map", "AoR"), toMaybe(f)))
+}
+
+// Filter returns an AoR of the elements of a valid AoR for which a function
+// returns true, in order.  If the AoR is invalid or if any function returns an
+// invalid B, Filter returns an invalid AoR.
+func (m AoR) Filter(f func(x rune) B) AoR {
+	return AoR(Slice[rune](m).checkZero("Filter", "AoR").Filter(toMaybe(f)))
+}
+
+// Reject is the opposite of Filter: it returns an AoR of the elements of a
+// valid AoR for which a function returns false.
+func (m AoR) Reject(f func(x rune) B) AoR {
+	return AoR(Slice[rune](m).checkZero("Reject", "AoR").Reject(toMaybe(f)))
+}
+
+// Partition splits a valid AoR into the elements for which a function returns
+// true and those for which it returns false.  If the AoR is invalid or if any
+// function returns an invalid B, both results are invalid.
+func (m AoR) Partition(f func(x rune) B) (AoR, AoR) {
+	in, out := Slice[rune](m).checkZero("Partition", "AoR").Partition(toMaybe(f))
+	return AoR(in), AoR(out)
+}
+
+// JoinStr joins the runes of a valid AoR into an S.  If the AoR is invalid,
+// JoinStr returns an invalid S.
+func (m AoR) JoinStr() S {
+	if m.IsErr() {
+		return ErrS(zeroErr(m.err, "JoinStr", "AoR"))
+	}
+
+	return JustS(string(m.just))
+}
+
+// IsNothing returns true for an AoR that holds nothing, i.e. one whose error is
+// ErrNothing itself.  Such an AoR is also invalid, so IsErr returns true.  An
+// error that only wraps ErrNothing, such as an ElementError for a missing
+// element, is an ordinary error.
+func (m AoR) IsNothing() bool {
+	return Slice[rune](m).IsNothing()
+}
+
+// JustOr returns a valid AoR holding a default value if the AoR holds nothing,
+// or otherwise returns the AoR unchanged.  Unlike OrElse, it leaves other
+// errors in place.  A nil default is replaced by an empty one, as for OrElse.
+func (m AoR) JustOr(x []rune) AoR {
+	return AoR(Slice[rune](m).JustOr(x))
+}
+
+// OrElse returns the AoR if it is valid, or otherwise a valid AoR holding a
+// default value.  A nil default is replaced by an empty one, so the result is
+// always valid.
+func (m AoR) OrElse(x []rune) AoR {
+	return AoR(Slice[rune](m).OrElse(x))
+}
+
+// Recover returns the AoR if it is valid, or otherwise a valid AoR holding the
+// value returned by a function that takes the error, with nil replaced by an
+// empty value as for OrElse.
+func (m AoR) Recover(f func(err error) []rune) AoR {
+	return AoR(Slice[rune](m).checkZero("Unbox", "AoR").Recover(f))
+}
+
+// MapErr returns the AoR if it is valid, or otherwise an invalid AoR with the
+// error returned by a function that takes the original error, e.g. to add
+// context with fmt.Errorf and %w.  If the function returns nil, the original
+// error is kept.
+func (m AoR) MapErr(f func(err error) error) AoR {
+	return AoR(Slice[rune](m).checkZero("Unbox", "AoR").MapErr(f))
+}
+
+// Catch is like Recover, but only for errors that match a target according
+// to errors.Is, and the function returns an AoR, so it may fail again.  Valid
+// values and other errors are returned unchanged.
+func (m AoR) Catch(target error, f func(err error) AoR) AoR {
+	return AoR(Slice[rune](m).checkZero("Unbox", "AoR").Catch(target, toSlice(f)))
+}
+
+// MarshalJSON implements json.Marshaler using the wire format described in
+// the package documentation.
+func (m AoR) MarshalJSON() ([]byte, error) {
+	return marshalJSON(m.Unbox())
+}
+
+// UnmarshalJSON implements json.Unmarshaler using the wire format described
+// in the package documentation.
+func (m *AoR) UnmarshalJSON(b []byte) error {
+	return unmarshalJSON(b, (*Maybe[[]rune])(m), "AoR")
+}
+
+// String returns a string representation, mostly useful for debugging.
+// Runes are shown as quoted characters rather than numbers.
+func (m AoR) String() string {
+	if m.IsErr() {
+		return fmt.Sprintf("Err %v", m.err)
+	}
+	return fmt.Sprintf("Just %q", m.just)
+}
+
+// Unbox returns the underlying slice of runes or error.
+func (m AoR) Unbox() ([]rune, error) {
+	if m.just == nil && m.err == nil {
+		return nil, &OpError{Op: "Unbox", Type: "AoR", Err: ErrZeroValue}
+	}
+	return m.just, m.err
+}
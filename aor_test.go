@@ -0,0 +1,78 @@
+package maybe_test
+
+import (
+	"errors"
+	"testing"
+	"unicode"
+
+	"github.com/xdg/maybe"
+	"github.com/xdg/testy"
+)
+
+func TestAoR(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	input := []rune("héllo")
+	good := maybe.JustAoR(input)
+	bad := maybe.ErrAoR(errors.New("bad runes"))
+	var got maybe.AoR
+	var just []rune
+	var err error
+
+	just, err = good.Unbox()
+	is.Equal(just, input)
+	is.Nil(err)
+	is.False(good.IsErr())
+
+	just, err = bad.Unbox()
+	is.Nil(just)
+	is.NotNil(err)
+	is.Equal(err.Error(), "bad runes")
+	is.True(bad.IsErr())
+
+	got = maybe.NewAoR(input, nil)
+	is.Equal(got, good)
+
+	got = maybe.NewAoR(nil, err)
+	is.True(got.IsErr())
+
+	is.Equal(good.String(), "Just ['h' 'é' 'l' 'l' 'o']")
+	is.Equal(bad.String(), "Err bad runes")
+
+	zero := maybe.AoR{}
+	is.True(zero.IsErr())
+	_, err = zero.Unbox()
+	is.NotNil(err)
+}
+
+func TestAoRMapJoin(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	upper := func(x rune) maybe.R { return maybe.JustR(unicode.ToUpper(x)) }
+	letter := func(x rune) maybe.R {
+		if !unicode.IsLetter(x) {
+			return maybe.ErrR(errors.New("not a letter"))
+		}
+		return maybe.JustR(x)
+	}
+
+	s, err := maybe.JustS("héllo").SplitRunes().Map(upper).JoinStr().Unbox()
+	is.Equal(s, "HÉLLO")
+	is.Nil(err)
+
+	_, err = maybe.JustS("h3llo").SplitRunes().Map(letter).Unbox()
+	is.Equal(err.Error(), "element [1]: not a letter")
+
+	xs, err := maybe.JustS("").SplitRunes().Unbox()
+	is.Equal(xs, []rune{})
+	is.Nil(err)
+
+	xs, err = maybe.JustS("a\xffb").SplitRunes().Unbox()
+	is.Equal(xs, []rune{'a', unicode.ReplacementChar, 'b'})
+	is.Nil(err)
+
+	is.True(maybe.ErrS(errors.New("bad string")).SplitRunes().IsErr())
+	is.True(maybe.ErrAoR(errors.New("bad runes")).JoinStr().IsErr())
+}
@@ -1,18 +1,16 @@
 package maybe
 
 import (
-	"errors"
+	"context"
 	"fmt"
+	"time"
 )
 
 // AoS implements the Maybe monad for a slice of strings.  An AoS is
 // considered 'valid' or 'invalid' depending on whether it contains a slice of
 // strings or an error value.  A zero-value AoS is invalid and Unbox() will
 // return an error to that effect.
-type AoS struct {
-	just []string
-	err  error
-}
+type AoS Slice[string]
 
 // NewAoS constructs an AoS from a given slice of strings or error. If e is
 // not nil, returns ErrAoS(e), otherwise returns JustAoS(s)
@@ -33,6 +31,19 @@ func ErrAoS(e error) AoS {
 	return AoS{err: e}
 }
 
+// NothingAoS constructs an invalid AoS that holds nothing, for a missing rather
+// than a failed value.  See ErrNothing.
+func NothingAoS() AoS {
+	return AoS{err: ErrNothing}
+}
+
+// SequenceS turns a slice of S into an AoS of their values.  If any element
+// is invalid, SequenceS returns an invalid AoS with the error of the first
+// one, tagged with its index.  Use SequenceAll to get all the errors.
+func SequenceS(xs []S) AoS {
+	return AoS(Sequence(xs))
+}
+
 // IsErr returns true for an invalid AoS.
 func (m AoS) IsErr() bool {
 	return m.just == nil || m.err != nil
@@ -44,76 +55,328 @@ func (m AoS) Bind(f func(s []string) AoS) AoS {
 		return m
 	}
 
-	return f(m.just)
+	return try(f, m.just, ErrAoS)
+}
+
+// BindCtx is like Bind, but the function also takes a context.  If the
+// context is done, BindCtx returns an invalid AoS with the context's error
+// instead of calling the function.
+func (m AoS) BindCtx(ctx context.Context, f func(ctx context.Context, s []string) AoS) AoS {
+	if m.IsErr() {
+		return m
+	}
+	if err := ctx.Err(); err != nil {
+		return ErrAoS(err)
+	}
+
+	return tryCtx(ctx, f, m.just, ErrAoS)
 }
 
 // Join applies a function that takes a slice of strings and returns an S.
 func (m AoS) Join(f func(s []string) S) S {
 	if m.IsErr() {
-		return ErrS(m.err)
+		return ErrS(zeroErr(m.err, "Join", "AoS"))
 	}
 
-	return f(m.just)
+	return try(f, m.just, ErrS)
+}
+
+// JoinCtx is like Join, but the function also takes a context.  If the
+// context is done, JoinCtx returns an invalid S with the context's error
+// instead of calling the function.
+func (m AoS) JoinCtx(ctx context.Context, f func(ctx context.Context, s []string) S) S {
+	if m.IsErr() {
+		return ErrS(zeroErr(m.err, "JoinCtx", "AoS"))
+	}
+	if err := ctx.Err(); err != nil {
+		return ErrS(err)
+	}
+
+	return tryCtx(ctx, f, m.just, ErrS)
 }
 
 // Split applies a splitting function to each element of a valid AoS,
 // resulting in a higher-dimension structure. If the AoS is invalid or if any
 // function returns an invalid AoS, Split returns an invalid AoAoS.
 func (m AoS) Split(f func(s string) AoS) AoAoS {
-	if m.IsErr() {
-		return ErrAoAoS(m.err)
-	}
-
-	xss := make([][]string, len(m.just))
-	for i, v := range m.just {
-		xs, err := f(v).Unbox()
-		if err != nil {
-			return ErrAoAoS(err)
-		}
-		xss[i] = xs
-	}
+	return AoAoS(SplitSlice(Slice[string](m).checkZero("Split", "AoS"), toSlice(f)))
+}
 
-	return JustAoAoS(xss)
+// SplitCtx is like Split, but the function also takes a context.  The
+// context is checked before each element; once it is done, SplitCtx stops and
+// returns an invalid AoAoS with the context's error.
+func (m AoS) SplitCtx(ctx context.Context, f func(ctx context.Context, s string) AoS) AoAoS {
+	return AoAoS(SplitSliceCtx(ctx, Slice[string](m).checkZero("SplitCtx", "AoS"), toSliceCtx(f)))
 }
 
 // Map applies a function to each element of a valid AoS and returns a new
 // AoS.  If the AoS is invalid or if any function returns an invalid S, Map
 // returns an invalid AoS.
 func (m AoS) Map(f func(s string) S) AoS {
-	if m.IsErr() {
-		return m
-	}
+	return AoS(MapSlice(Slice[string](m).checkZero("Map", "AoS"), toMaybe(f)))
+}
 
-	xss := make([]string, len(m.just))
-	for i, v := range m.just {
-		str, err := f(v).Unbox()
-		if err != nil {
-			return ErrAoS(err)
-		}
-		xss[i] = str
-	}
+// Filter returns an AoS of the elements of a valid AoS for which a function
+// returns true, in order.  If the AoS is invalid or if any function returns an
+// invalid B, Filter returns an invalid AoS.
+func (m AoS) Filter(f func(s string) B) AoS {
+	return AoS(Slice[string](m).checkZero("Filter", "AoS").Filter(toMaybe(f)))
+}
 
-	return JustAoS(xss)
+// Reject is the opposite of Filter: it returns an AoS of the elements of a
+// valid AoS for which a function returns false.
+func (m AoS) Reject(f func(s string) B) AoS {
+	return AoS(Slice[string](m).checkZero("Reject", "AoS").Reject(toMaybe(f)))
+}
+
+// Partition splits a valid AoS into the elements for which a function returns
+// true and those for which it returns false.  If the AoS is invalid or if any
+// function returns an invalid B, both results are invalid.
+func (m AoS) Partition(f func(s string) B) (AoS, AoS) {
+	in, out := Slice[string](m).checkZero("Partition", "AoS").Partition(toMaybe(f))
+	return AoS(in), AoS(out)
+}
+
+// Fold combines the elements of a valid AoS into a single S, starting from an
+// initial value and applying a function to the accumulator and each element
+// in turn.  An empty AoS results in the initial value.  If the AoS is invalid
+// or if any function returns an invalid S, Fold stops and returns an invalid
+// S.
+func (m AoS) Fold(init string, f func(acc string, s string) S) S {
+	return S(FoldSlice(Slice[string](m).checkZero("Fold", "AoS"), init, toMaybe2(f)))
+}
+
+// Scan is like Fold, but returns an AoS of the accumulator after each
+// element, e.g. running totals.  If the AoS is invalid or if any function
+// returns an invalid S, Scan returns an invalid AoS.
+func (m AoS) Scan(init string, f func(acc string, s string) S) AoS {
+	return AoS(ScanSlice(Slice[string](m).checkZero("Scan", "AoS"), init, toMaybe2(f)))
+}
+
+// Zip pairs up the elements of two valid AoSs of the same length, resulting
+// in an AoAoS with a row of two elements for each pair.  If either AoS is
+// invalid, Zip returns an invalid AoAoS with the error of the first invalid
+// one; if their lengths differ, the error wraps ErrLengthMismatch.
+func (m AoS) Zip(other AoS) AoAoS {
+	return AoAoS(Slice[string](m).checkZero("Zip", "AoS").Zip(Slice[string](other).checkZero("Zip", "AoS")))
+}
+
+// ZipWith applies a function to each pair of elements of two valid AoSs of
+// the same length and returns a new AoS.  It fails as Zip does, or if any
+// function returns an invalid S.
+func (m AoS) ZipWith(other AoS, f func(x, y string) S) AoS {
+	return AoS(ZipSliceWith(Slice[string](m).checkZero("ZipWith", "AoS"), Slice[string](other).checkZero("ZipWith", "AoS"), toMaybe2(f)))
+}
+
+// MapCtx is like Map, but the function also takes a context.  The context is
+// checked before each element; once it is done, MapCtx stops and returns an
+// invalid AoS with the context's error.
+func (m AoS) MapCtx(ctx context.Context, f func(ctx context.Context, s string) S) AoS {
+	return AoS(MapSliceCtx(ctx, Slice[string](m).checkZero("MapCtx", "AoS"), toMaybeCtx(f)))
+}
+
+// MapAll is like Map, but applies the function to every element even after
+// a failure.  If any function returns an invalid S, MapAll returns an
+// invalid AoS whose error joins the errors from all failing elements, each
+// tagged with its index.
+func (m AoS) MapAll(f func(s string) S) AoS {
+	return AoS(MapSliceAll(Slice[string](m).checkZero("MapAll", "AoS"), toMaybe(f)))
+}
+
+// MapResults applies a function to each element of a valid AoS and keeps the
+// outcome for every element, so that the valid results can be used and the
+// failures reported.  If the AoS is invalid, MapResults returns an invalid
+// Results.
+func (m AoS) MapResults(f func(s string) S) Results[string] {
+	return MapResults(Slice[string](m).checkZero("MapResults", "AoS"), toMaybe(f))
+}
+
+// ParallelMap is like Map, but runs the function on up to n elements
+// concurrently, or GOMAXPROCS elements if n is less than 1.  The order of
+// elements is preserved.  Once a function returns an invalid S, no further
+// elements are started and ParallelMap returns an invalid AoS.
+func (m AoS) ParallelMap(n int, f func(s string) S) AoS {
+	return AoS(ParallelMapSlice(Slice[string](m).checkZero("ParallelMap", "AoS"), n, toMaybe(f)))
+}
+
+// Seq returns a lazy sequence of the elements of the AoS.  If the AoS is
+// invalid, the sequence yields only its error.
+func (m AoS) Seq() Seq[string] {
+	return Slice[string](m).checkZero("Seq", "AoS").Seq()
 }
 
 // ToInt applies a function that takes a string and returns an I.If the AoS is
 // invalid or if any function returns an invalid I, ToInt returns an invalid
 // AoI.
 func (m AoS) ToInt(f func(s string) I) AoI {
+	return AoI(MapSlice(Slice[string](m).checkZero("ToInt", "AoS"), toMaybe(f)))
+}
+
+// ToFloat applies a function that takes a string and returns an F.  If the
+// AoS is invalid or if any function returns an invalid F, ToFloat returns an
+// invalid AoF.
+func (m AoS) ToFloat(f func(s string) F) AoF {
+	return AoF(MapSlice(Slice[string](m).checkZero("ToFloat", "AoS"), toMaybe(f)))
+}
+
+// ToBool applies a function that takes a string and returns a B, such as a
+// predicate, to each element.  If the AoS is invalid or if any function
+// returns an invalid B, ToBool returns an invalid AoB.
+func (m AoS) ToBool(f func(s string) B) AoB {
+	return AoB(MapSlice(Slice[string](m).checkZero("ToBool", "AoS"), toMaybe(f)))
+}
+
+// SplitRunes splits each element of a valid AoS into its Unicode code points,
+// resulting in an AoAoR, e.g. to treat lines of text as a grid of
+// characters.  If the AoS is invalid, SplitRunes returns an invalid AoAoR.
+func (m AoS) SplitRunes() AoAoR {
 	if m.IsErr() {
-		return ErrAoI(m.err)
+		return ErrAoAoR(zeroErr(m.err, "SplitRunes", "AoS"))
 	}
 
-	xss := make([]int, len(m.just))
+	xss := make([][]rune, len(m.just))
 	for i, v := range m.just {
-		num, err := f(v).Unbox()
-		if err != nil {
-			return ErrAoI(err)
-		}
-		xss[i] = num
+		xss[i] = []rune(v)
 	}
 
-	return JustAoI(xss)
+	return JustAoAoR(xss)
+}
+
+// ToBytes converts each element of a valid AoS to a byte slice, resulting in
+// an AoBytes.  If the AoS is invalid, ToBytes returns an invalid AoBytes.
+func (m AoS) ToBytes() AoBytes {
+	if m.IsErr() {
+		return ErrAoBytes(zeroErr(m.err, "ToBytes", "AoS"))
+	}
+
+	xss := make([][]byte, len(m.just))
+	for i, v := range m.just {
+		xss[i] = []byte(v)
+	}
+
+	return JustAoBytes(xss)
+}
+
+// ToTime parses each element of a valid AoS as a time according to a
+// layout, resulting in an AoT.  If the AoS is invalid or any element can't be
+// parsed, ToTime returns an invalid AoT.
+func (m AoS) ToTime(layout string) AoT {
+	return AoT(MapSlice(Slice[string](m).checkZero("ToTime", "AoS"), func(s string) Maybe[time.Time] {
+		return New(time.Parse(layout, s))
+	}))
+}
+
+// ToDuration parses each element of a valid AoS as a duration, resulting in
+// an AoD.  If the AoS is invalid or any element can't be parsed, ToDuration
+// returns an invalid AoD.
+func (m AoS) ToDuration() AoD {
+	return AoD(MapSlice(Slice[string](m).checkZero("ToDuration", "AoS"), func(s string) Maybe[time.Duration] {
+		return New(time.ParseDuration(s))
+	}))
+}
+
+// ToI64 parses each element of a valid AoS as a base-10 int64, resulting in an
+// AoI64.  If the AoS is invalid or any element can't be converted, ToI64
+// returns an invalid AoI64.
+func (m AoS) ToI64() AoI64 {
+	return AoI64(MapSlice(Slice[string](m).checkZero("ToI64", "AoS"), parseI64))
+}
+
+// ToU64 parses each element of a valid AoS as a base-10 uint64, resulting in an
+// AoU64.  If the AoS is invalid or any element can't be converted, ToU64
+// returns an invalid AoU64.
+func (m AoS) ToU64() AoU64 {
+	return AoU64(MapSlice(Slice[string](m).checkZero("ToU64", "AoS"), parseU64))
+}
+
+// ToBigI parses each element of a valid AoS as a base-10 *big.Int, resulting in
+// an AoBigI.  If the AoS is invalid or any element can't be converted, ToBigI
+// returns an invalid AoBigI.
+func (m AoS) ToBigI() AoBigI {
+	return AoBigI(MapSlice(Slice[string](m).checkZero("ToBigI", "AoS"), parseBigI))
+}
+
+// ToIntCtx is like ToInt, but the function also takes a context.  The
+// context is checked before each element; once it is done, ToIntCtx stops and
+// returns an invalid AoI with the context's error.
+func (m AoS) ToIntCtx(ctx context.Context, f func(ctx context.Context, s string) I) AoI {
+	return AoI(MapSliceCtx(ctx, Slice[string](m).checkZero("ToIntCtx", "AoS"), toMaybeCtx(f)))
+}
+
+// ToIntAll is like ToInt, but applies the function to every element even
+// after a failure.  If any function returns an invalid I, ToIntAll returns an
+// invalid AoI whose error joins the errors from all failing elements, each
+// tagged with its index.
+func (m AoS) ToIntAll(f func(s string) I) AoI {
+	return AoI(MapSliceAll(Slice[string](m).checkZero("ToIntAll", "AoS"), toMaybe(f)))
+}
+
+// ToIntResults is like ToInt, but keeps the outcome for every element as
+// MapResults does.
+func (m AoS) ToIntResults(f func(s string) I) Results[int] {
+	return MapResults(Slice[string](m).checkZero("ToIntResults", "AoS"), toMaybe(f))
+}
+
+// ParallelToInt is like ToInt, but runs the function on up to n elements
+// concurrently, with the same ordering and error semantics as ParallelMap.
+func (m AoS) ParallelToInt(n int, f func(s string) I) AoI {
+	return AoI(ParallelMapSlice(Slice[string](m).checkZero("ParallelToInt", "AoS"), n, toMaybe(f)))
+}
+
+// IsNothing returns true for an AoS that holds nothing, i.e. one whose error is
+// ErrNothing itself.  Such an AoS is also invalid, so IsErr returns true.  An
+// error that only wraps ErrNothing, such as an ElementError for a missing
+// element, is an ordinary error.
+func (m AoS) IsNothing() bool {
+	return Slice[string](m).IsNothing()
+}
+
+// JustOr returns a valid AoS holding a default value if the AoS holds nothing,
+// or otherwise returns the AoS unchanged.  Unlike OrElse, it leaves other
+// errors in place.  A nil default is replaced by an empty one, as for OrElse.
+func (m AoS) JustOr(x []string) AoS {
+	return AoS(Slice[string](m).JustOr(x))
+}
+
+// OrElse returns the AoS if it is valid, or otherwise a valid AoS holding a
+// default value.  A nil default is replaced by an empty one, so the result is
+// always valid.
+func (m AoS) OrElse(x []string) AoS {
+	return AoS(Slice[string](m).OrElse(x))
+}
+
+// Recover returns the AoS if it is valid, or otherwise a valid AoS holding the
+// value returned by a function that takes the error, with nil replaced by an
+// empty value as for OrElse.
+func (m AoS) Recover(f func(err error) []string) AoS {
+	return AoS(Slice[string](m).checkZero("Unbox", "AoS").Recover(f))
+}
+
+// MapErr returns the AoS if it is valid, or otherwise an invalid AoS with the
+// error returned by a function that takes the original error, e.g. to add
+// context with fmt.Errorf and %w.  If the function returns nil, the original
+// error is kept.
+func (m AoS) MapErr(f func(err error) error) AoS {
+	return AoS(Slice[string](m).checkZero("Unbox", "AoS").MapErr(f))
+}
+
+// Catch is like Recover, but only for errors that match a target according
+// to errors.Is, and the function returns an AoS, so it may fail again.  Valid
+// values and other errors are returned unchanged.
+func (m AoS) Catch(target error, f func(err error) AoS) AoS {
+	return AoS(Slice[string](m).checkZero("Unbox", "AoS").Catch(target, toSlice(f)))
+}
+
+// MarshalJSON implements json.Marshaler using the wire format described in
+// the package documentation.
+func (m AoS) MarshalJSON() ([]byte, error) {
+	return marshalJSON(m.Unbox())
+}
+
+// UnmarshalJSON implements json.Unmarshaler using the wire format described
+// in the package documentation.
+func (m *AoS) UnmarshalJSON(b []byte) error {
+	return unmarshalJSON(b, (*Maybe[[]string])(m), "AoS")
 }
 
 // String returns a string representation, mostly useful for debugging.
@@ -127,7 +390,7 @@ func (m AoS) String() string {
 // Unbox returns the underlying slice of strings value or error.
 func (m AoS) Unbox() ([]string, error) {
 	if m.just == nil && m.err == nil {
-		return nil, errors.New("zero-value AoS")
+		return nil, &OpError{Op: "Unbox", Type: "AoS", Err: ErrZeroValue}
 	}
 	return m.just, m.err
 }
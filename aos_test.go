@@ -2,6 +2,7 @@ package maybe_test
 
 import (
 	"errors"
+	"fmt"
 	"strconv"
 	"strings"
 	"testing"
@@ -187,3 +188,156 @@ func TestAoSToInt(t *testing.T) {
 	got = bad.ToInt(f)
 	is.True(got.IsErr())
 }
+
+func TestAoSToIntAll(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	input := []string{"42", "23"}
+	good, bad := getStrFixtures(input)
+	notNum := maybe.JustAoS([]string{"twenty-three", "42", "forty-two"})
+	var got maybe.AoI
+	var err error
+
+	f := func(s string) maybe.I { return maybe.NewI(strconv.Atoi(s)) }
+
+	// Convert S to I; good path
+	got = good.ToIntAll(f)
+	x, err := got.Unbox()
+	is.Equal(x, []int{42, 23})
+	is.Nil(err)
+
+	// Convert S to I; every failure is reported
+	got = notNum.ToIntAll(f)
+	_, err = got.Unbox()
+	is.Equal(err.Error(), "element [0]: strconv.Atoi: parsing \"twenty-three\": invalid syntax\n"+
+		"element [2]: strconv.Atoi: parsing \"forty-two\": invalid syntax")
+	is.True(errors.Is(err, strconv.ErrSyntax))
+
+	// Convert invalid S to I
+	got = bad.ToIntAll(f)
+	is.True(got.IsErr())
+
+	// MapAll reports every failure as well
+	lcBadMap := good.MapAll(func(s string) maybe.S { return maybe.ErrS(errors.New("bad string")) })
+	_, err = lcBadMap.Unbox()
+	is.Equal(err.Error(), "element [0]: bad string\nelement [1]: bad string")
+
+	// A Nothing element doesn't hide a real failure in another one
+	orNothing := func(s string) maybe.I {
+		if s == "" {
+			return maybe.NothingI()
+		}
+		return f(s)
+	}
+	got = maybe.JustAoS([]string{"x", ""}).ToIntAll(orNothing)
+	is.False(got.IsNothing())
+	_, err = got.JustOr([]int{0}).Unbox()
+	is.True(errors.Is(err, strconv.ErrSyntax))
+	is.True(errors.Is(err, maybe.ErrNothing))
+}
+
+func TestAoSRecovery(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	atoi := func(s string) maybe.I { return maybe.NewI(strconv.Atoi(s)) }
+	bad := maybe.JustAoS([]string{"1", "x"}).ToInt(atoi)
+
+	xs, err := bad.OrElse([]int{}).Unbox()
+	is.Equal(xs, []int{})
+	is.Nil(err)
+	is.False(bad.OrElse(nil).IsErr())
+
+	_, err = bad.MapErr(func(err error) error { return fmt.Errorf("ports: %w", err) }).Unbox()
+	is.Equal(err.Error(), `ports: element [1]: strconv.Atoi: parsing "x": invalid syntax`)
+
+	xs, err = bad.Catch(strconv.ErrSyntax, func(err error) maybe.AoI {
+		return maybe.JustAoI([]int{80})
+	}).Unbox()
+	is.Equal(xs, []int{80})
+	is.Nil(err)
+
+	is.True(bad.Catch(strconv.ErrRange, func(err error) maybe.AoI {
+		return maybe.JustAoI([]int{80})
+	}).IsErr())
+}
+
+func TestAoSFilter(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	// Predicates returning (bool, error) fit with NewB
+	enabled := func(s string) maybe.B { return maybe.NewB(strconv.ParseBool(s)) }
+
+	xs, err := maybe.JustAoS([]string{"true", "false", "1"}).Filter(enabled).Unbox()
+	is.Equal(xs, []string{"true", "1"})
+	is.Nil(err)
+
+	_, err = maybe.JustAoS([]string{"true", "maybe"}).Reject(enabled).Unbox()
+	var ee *maybe.ElementError
+	is.True(errors.As(err, &ee))
+	is.Equal(ee.Index, []int{1})
+	is.True(errors.Is(err, strconv.ErrSyntax))
+}
+
+func TestAoSFold(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	path := func(acc, s string) maybe.S {
+		if s == "" {
+			return maybe.ErrS(errors.New("empty segment"))
+		}
+		return maybe.JustS(acc + "/" + s)
+	}
+	good := maybe.JustAoS([]string{"usr", "local", "bin"})
+
+	is.Equal(good.Fold("", path), maybe.JustS("/usr/local/bin"))
+	is.Equal(good.Scan("", path), maybe.JustAoS([]string{"/usr", "/usr/local", "/usr/local/bin"}))
+
+	_, err := maybe.JustAoS([]string{"usr", ""}).Scan("", path).Unbox()
+	is.Equal(err.Error(), "element [1]: empty segment")
+	is.True(maybe.ErrAoS(errors.New("bad strings")).Scan("", path).IsErr())
+}
+
+func TestAoSZip(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	keys := maybe.JustAoS([]string{"a", "b"})
+	values := maybe.JustAoS([]string{"1", "2"})
+
+	pairs := keys.Zip(values)
+	is.Equal(pairs, maybe.JustAoAoS([][]string{{"a", "1"}, {"b", "2"}}))
+	is.Equal(pairs.ToMoS(true), maybe.JustMoS(map[string]string{"a": "1", "b": "2"}))
+	k, v := pairs.Unzip()
+	is.Equal(k, keys)
+	is.Equal(v, values)
+
+	eq := func(x, y string) maybe.S { return maybe.JustS(x + "=" + y) }
+	is.Equal(keys.ZipWith(values, eq), maybe.JustAoS([]string{"a=1", "b=2"}))
+
+	// Mixed types zip through the generic functions
+	counts := maybe.JustAoI([]int{3, 4})
+	is.Equal(maybe.ZipSlice(maybe.Slice[string](keys), maybe.Slice[int](counts)),
+		maybe.JustSlice([]maybe.Pair[string, int]{{"a", 3}, {"b", 4}}))
+
+	_, err := keys.Zip(maybe.ErrAoS(errors.New("bad strings"))).Unbox()
+	is.Equal(err.Error(), "bad strings")
+	_, err = keys.ZipWith(maybe.JustAoS([]string{}), eq).Unbox()
+	is.True(errors.Is(err, maybe.ErrLengthMismatch))
+	k, v = maybe.JustAoAoS([][]string{{"a"}}).Unzip()
+	is.True(k.IsErr())
+	is.True(v.IsErr())
+}
+
+func TestSequenceS(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	is.Equal(maybe.SequenceS([]maybe.S{maybe.JustS("a"), maybe.JustS("b")}), maybe.JustAoS([]string{"a", "b"}))
+
+	_, err := maybe.SequenceS([]maybe.S{maybe.JustS("a"), maybe.ErrS(errors.New("bad"))}).Unbox()
+	is.Equal(err.Error(), "element [1]: bad")
+}
@@ -0,0 +1,235 @@
+package maybe
+
+import (
+	"fmt"
+	"time"
+)
+
+// AoT implements the Maybe monad for a slice of times.  An AoT is
+// considered 'valid' or 'invalid' depending on whether it contains a slice of
+// times or an error value.  A zero-value AoT is invalid and Unbox() will
+// return an error to that effect.
+type AoT Slice[time.Time]
+
+// NewAoT constructs an AoT from a given slice of times or error. If e is
+// not nil, returns ErrAoT(e), otherwise returns JustAoT(x).
+func NewAoT(x []time.Time, e error) AoT {
+	if e != nil {
+		return ErrAoT(e)
+	}
+	return JustAoT(x)
+}
+
+// JustAoT constructs a valid AoT from a given slice of times.
+func JustAoT(x []time.Time) AoT {
+	return AoT{just: x}
+}
+
+// ErrAoT constructs an invalid AoT from a given error.
+func ErrAoT(e error) AoT {
+	return AoT{err: e}
+}
+
+// NothingAoT constructs an invalid AoT that holds nothing, for a missing rather
+// than a failed value.  See ErrNothing.
+func NothingAoT() AoT {
+	return AoT{err: ErrNothing}
+}
+
+// IsErr returns true for an invalid AoT.
+func (m AoT) IsErr() bool {
+	return m.just == nil || m.err != nil
+}
+
+// Bind applies a function that takes a slice of times and returns an AoT.
+func (m AoT) Bind(f func(x []time.Time) AoT) AoT {
+	if m.IsErr() {
+		return m
+	}
+
+	return try(f, m.just, ErrAoT)
+}
+
+// Join applies a function that takes a slice of times and returns a T.
+func (m AoT) Join(f func(x []time.Time) T) T {
+	if m.IsErr() {
+		return ErrT(zeroErr(m.err, "Join", "AoT"))
+	}
+
+	return try(f, m.just, ErrT)
+}
+
+// Split applies a splitting function to each element of a valid AoT,
+// resulting in a higher-dimension structure. If the AoT is invalid or if any
+// function returns an invalid AoT, Split returns an invalid AoAoT.
+func (m AoT) Split(f func(x time.Time) AoT) AoAoT {
+	return AoAoT(SplitSlice(Slice[time.Time](m).checkZero("Split", "AoT"), toSlice(f)))
+}
+
+// Map applies a function to each element of a valid AoT and returns a new
+// AoT.  If the AoT is invalid or if any function returns an invalid T, Map
+// returns an invalid AoT.
+func (m AoT) Map(f func(x time.Time) T) AoT {
+	return AoT(MapSlice(Slice[time.Time](m).checkZero("Map", "AoT"), toMaybe(f)))
+}
+
+// Filter returns an AoT of the elements of a valid AoT for which a function
+// returns true, in order.  If the AoT is invalid or if any function returns an
+// invalid B, Filter returns an invalid AoT.
+func (m AoT) Filter(f func(x time.Time) B) AoT {
+	return AoT(Slice[time.Time](m).checkZero("Filter", "AoT").Filter(toMaybe(f)))
+}
+
+// Reject is the opposite of Filter: it returns an AoT of the elements of a
+// valid AoT for which a function returns false.
+func (m AoT) Reject(f func(x time.Time) B) AoT {
+	return AoT(Slice[time.Time](m).checkZero("Reject", "AoT").Reject(toMaybe(f)))
+}
+
+// Partition splits a valid AoT into the elements for which a function returns
+// true and those for which it returns false.  If the AoT is invalid or if any
+// function returns an invalid B, both results are invalid.
+func (m AoT) Partition(f func(x time.Time) B) (AoT, AoT) {
+	in, out := Slice[time.Time](m).checkZero("Partition", "AoT").Partition(toMaybe(f))
+	return AoT(in), AoT(out)
+}
+
+// Format formats each element of a valid AoT according to a layout,
+// resulting in an AoS.  If the AoT is invalid, Format returns an invalid AoS.
+func (m AoT) Format(layout string) AoS {
+	return AoS(MapSlice(Slice[time.Time](m).checkZero("Format", "AoT"), func(x time.Time) Maybe[string] {
+		return Just(x.Format(layout))
+	}))
+}
+
+// Earliest returns the earliest time in a valid AoT.  If the AoT is invalid
+// or empty, Earliest returns an invalid T, with an error wrapping
+// ErrNoElements if it is empty.
+func (m AoT) Earliest() T {
+	return m.Join(func(xs []time.Time) T {
+		if len(xs) == 0 {
+			return ErrT(&OpError{Op: "Earliest", Type: "AoT", Err: ErrNoElements})
+		}
+		min := xs[0]
+		for _, v := range xs[1:] {
+			if v.Before(min) {
+				min = v
+			}
+		}
+		return JustT(min)
+	})
+}
+
+// Latest returns the latest time in a valid AoT.  If the AoT is invalid or
+// empty, Latest returns an invalid T, with an error wrapping ErrNoElements if
+// it is empty.
+func (m AoT) Latest() T {
+	return m.Join(func(xs []time.Time) T {
+		if len(xs) == 0 {
+			return ErrT(&OpError{Op: "Latest", Type: "AoT", Err: ErrNoElements})
+		}
+		max := xs[0]
+		for _, v := range xs[1:] {
+			if v.After(max) {
+				max = v
+			}
+		}
+		return JustT(max)
+	})
+}
+
+// Span returns the duration between the earliest and latest times in a
+// valid AoT.  If the AoT is invalid or empty, Span returns an invalid D, with
+// an error wrapping ErrNoElements if it is empty.  If the span is too long for
+// a time.Duration, about 292 years, Span returns an invalid D with an error
+// wrapping strconv.ErrRange, as AoD.Sum does on overflow, rather than the
+// saturated value time.Time.Sub would give.
+func (m AoT) Span() D {
+	if m.IsErr() {
+		return ErrD(zeroErr(m.err, "Span", "AoT"))
+	}
+	if len(m.just) == 0 {
+		return ErrD(&OpError{Op: "Span", Type: "AoT", Err: ErrNoElements})
+	}
+	latest, _ := m.Latest().Unbox()
+	earliest, _ := m.Earliest().Unbox()
+	span := latest.Sub(earliest)
+	if !earliest.Add(span).Equal(latest) {
+		num := earliest.Format(time.RFC3339Nano) + " to " + latest.Format(time.RFC3339Nano)
+		return ErrD(&OpError{Op: "Span", Type: "AoT", Err: rangeErr("Span", num)})
+	}
+
+	return JustD(span)
+}
+
+// IsNothing returns true for an AoT that holds nothing, i.e. one whose error is
+// ErrNothing itself.  Such an AoT is also invalid, so IsErr returns true.  An
+// error that only wraps ErrNothing, such as an ElementError for a missing
+// element, is an ordinary error.
+func (m AoT) IsNothing() bool {
+	return Slice[time.Time](m).IsNothing()
+}
+
+// JustOr returns a valid AoT holding a default value if the AoT holds nothing,
+// or otherwise returns the AoT unchanged.  Unlike OrElse, it leaves other
+// errors in place.  A nil default is replaced by an empty one, as for OrElse.
+func (m AoT) JustOr(x []time.Time) AoT {
+	return AoT(Slice[time.Time](m).JustOr(x))
+}
+
+// OrElse returns the AoT if it is valid, or otherwise a valid AoT holding a
+// default value.  A nil default is replaced by an empty one, so the result is
+// always valid.
+func (m AoT) OrElse(x []time.Time) AoT {
+	return AoT(Slice[time.Time](m).OrElse(x))
+}
+
+// Recover returns the AoT if it is valid, or otherwise a valid AoT holding the
+// value returned by a function that takes the error, with nil replaced by an
+// empty value as for OrElse.
+func (m AoT) Recover(f func(err error) []time.Time) AoT {
+	return AoT(Slice[time.Time](m).checkZero("Unbox", "AoT").Recover(f))
+}
+
+// MapErr returns the AoT if it is valid, or otherwise an invalid AoT with the
+// error returned by a function that takes the original error, e.g. to add
+// context with fmt.Errorf and %w.  If the function returns nil, the original
+// error is kept.
+func (m AoT) MapErr(f func(err error) error) AoT {
+	return AoT(Slice[time.Time](m).checkZero("Unbox", "AoT").MapErr(f))
+}
+
+// Catch is like Recover, but only for errors that match a target according
+// to errors.Is, and the function returns an AoT, so it may fail again.  Valid
+// values and other errors are returned unchanged.
+func (m AoT) Catch(target error, f func(err error) AoT) AoT {
+	return AoT(Slice[time.Time](m).checkZero("Unbox", "AoT").Catch(target, toSlice(f)))
+}
+
+// MarshalJSON implements json.Marshaler using the wire format described in
+// the package documentation.
+func (m AoT) MarshalJSON() ([]byte, error) {
+	return marshalJSON(m.Unbox())
+}
+
+// UnmarshalJSON implements json.Unmarshaler using the wire format described
+// in the package documentation.
+func (m *AoT) UnmarshalJSON(b []byte) error {
+	return unmarshalJSON(b, (*Maybe[[]time.Time])(m), "AoT")
+}
+
+// String returns a string representation, mostly useful for debugging.
+func (m AoT) String() string {
+	if m.IsErr() {
+		return fmt.Sprintf("Err %v", m.err)
+	}
+	return fmt.Sprintf("Just %v", m.just)
+}
+
+// Unbox returns the underlying slice of times or error.
+func (m AoT) Unbox() ([]time.Time, error) {
+	if m.just == nil && m.err == nil {
+		return nil, &OpError{Op: "Unbox", Type: "AoT", Err: ErrZeroValue}
+	}
+	return m.just, m.err
+}
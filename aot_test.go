@@ -0,0 +1,95 @@
+package maybe_test
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/xdg/maybe"
+	"github.com/xdg/testy"
+)
+
+func TestAoT(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	input := []time.Time{
+		time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 3, 2, 0, 0, 0, 0, time.UTC),
+	}
+	good := maybe.JustAoT(input)
+	bad := maybe.ErrAoT(errors.New("bad times"))
+
+	just, err := good.Unbox()
+	is.Equal(just, input)
+	is.Nil(err)
+	is.False(good.IsErr())
+
+	just, err = bad.Unbox()
+	is.Nil(just)
+	is.Equal(err.Error(), "bad times")
+	is.True(bad.IsErr())
+
+	is.Equal(maybe.NewAoT(input, nil), good)
+	is.True(maybe.NewAoT(nil, err).IsErr())
+	is.Equal(bad.String(), "Err bad times")
+
+	zero := maybe.AoT{}
+	is.True(zero.IsErr())
+	_, err = zero.Unbox()
+	is.NotNil(err)
+
+	xs, err := good.Format(time.DateOnly).Unbox()
+	is.Equal(xs, []string{"2024-03-01", "2024-03-02"})
+	is.Nil(err)
+	is.True(bad.Format(time.DateOnly).IsErr())
+}
+
+func TestAoTAggregates(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	stamps := maybe.JustAoS([]string{"10:05:00", "09:58:30", "10:20:00"}).ToTime(time.TimeOnly)
+
+	earliest, err := stamps.Earliest().Format(time.TimeOnly).Unbox()
+	is.Equal(earliest, "09:58:30")
+	is.Nil(err)
+
+	latest, err := stamps.Latest().Format(time.TimeOnly).Unbox()
+	is.Equal(latest, "10:20:00")
+	is.Nil(err)
+
+	span, err := stamps.Span().Unbox()
+	is.Equal(span, 21*time.Minute+30*time.Second)
+	is.Nil(err)
+
+	_, err = maybe.JustAoS([]string{"10:05:00", "noon"}).ToTime(time.TimeOnly).Unbox()
+	var ee *maybe.ElementError
+	is.True(errors.As(err, &ee))
+	is.Equal(ee.Index, []int{1})
+
+	empty := maybe.JustAoT([]time.Time{})
+	is.True(empty.Earliest().IsErr())
+	is.True(empty.Latest().IsErr())
+	_, err = empty.Span().Unbox()
+	is.True(errors.Is(err, maybe.ErrNoElements))
+	is.Equal(err.Error(), "AoT.Span: no elements")
+	_, err = empty.Earliest().Unbox()
+	is.Equal(err.Error(), "AoT.Earliest: no elements")
+	_, err = empty.Latest().Unbox()
+	is.True(errors.Is(err, maybe.ErrNoElements))
+
+	bad := maybe.ErrAoT(errors.New("bad times"))
+	is.True(bad.Earliest().IsErr())
+	is.True(bad.Latest().IsErr())
+	is.True(bad.Span().IsErr())
+
+	// A span too long for a time.Duration is an error, not a saturated value
+	times := []time.Time{time.Date(1700, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC)}
+	_, err = maybe.JustAoT(times).Span().Unbox()
+	is.True(errors.Is(err, strconv.ErrRange))
+	is.Equal(err.Error(), `AoT.Span: strconv.Span: parsing "1700-01-01T00:00:00Z to 2100-01-01T00:00:00Z": value out of range`)
+	_, err = maybe.JustAoAoT([][]time.Time{{time.Now()}, times}).Span().Unbox()
+	is.True(errors.Is(err, strconv.ErrRange))
+}
@@ -0,0 +1,185 @@
+package maybe
+
+import "fmt"
+
+// AoU64 implements the Maybe monad for a slice of uint64s.  An AoU64 is
+// considered 'valid' or 'invalid' depending on whether it contains a slice of
+// uint64s or an error value.  A zero-value AoU64 is invalid and Unbox() will
+// return an error to that effect.
+type AoU64 Slice[uint64]
+
+// NewAoU64 constructs an AoU64 from a given slice of uint64s or error. If e is
+// not nil, returns ErrAoU64(e), otherwise returns JustAoU64(x).
+func NewAoU64(x []uint64, e error) AoU64 {
+	if e != nil {
+		return ErrAoU64(e)
+	}
+	return JustAoU64(x)
+}
+
+// JustAoU64 constructs a valid AoU64 from a given slice of uint64s.
+func JustAoU64(x []uint64) AoU64 {
+	return AoU64{just: x}
+}
+
+// ErrAoU64 constructs an invalid AoU64 from a given error.
+func ErrAoU64(e error) AoU64 {
+	return AoU64{err: e}
+}
+
+// NothingAoU64 constructs an invalid AoU64 that holds nothing, for a missing
+// rather than a failed value.  See ErrNothing.
+func NothingAoU64() AoU64 {
+	return AoU64{err: ErrNothing}
+}
+
+// IsErr returns true for an invalid AoU64.
+func (m AoU64) IsErr() bool {
+	return m.just == nil || m.err != nil
+}
+
+// Bind applies a function that takes a slice of uint64s and returns an AoU64.
+func (m AoU64) Bind(f func(x []uint64) AoU64) AoU64 {
+	if m.IsErr() {
+		return m
+	}
+
+	return try(f, m.just, ErrAoU64)
+}
+
+// Join applies a function that takes a slice of uint64s and returns a U64.
+func (m AoU64) Join(f func(x []uint64) U64) U64 {
+	if m.IsErr() {
+		return ErrU64(zeroErr(m.err, "Join", "AoU64"))
+	}
+
+	return try(f, m.just, ErrU64)
+}
+
+// Split applies a splitting function to each element of a valid AoU64,
+// resulting in a higher-dimension structure. If the AoU64 is invalid or if any
+// function returns an invalid AoU64, Split returns an invalid AoAoU64.
+func (m AoU64) Split(f func(x uint64) AoU64) AoAoU64 {
+	return AoAoU64(SplitSlice(Slice[uint64](m).checkZero("Split", "AoU64"), toSlice(f)))
+}
+
+// Map applies a function to each element of a valid AoU64 and returns a new
+// AoU64.  If the AoU64 is invalid or if any function returns an invalid U64,
+// Map returns an invalid AoU64.
+func (m AoU64) Map(f func(x uint64) U64) AoU64 {
+	return AoU64(MapSlice(Slice[uint64](m).checkZero("Map", "AoU64"), toMaybe(f)))
+}
+
+// Filter returns an AoU64 of the elements of a valid AoU64 for which a function
+// returns true, in order.  If the AoU64 is invalid or if any function returns
+// an invalid B, Filter returns an invalid AoU64.
+func (m AoU64) Filter(f func(x uint64) B) AoU64 {
+	return AoU64(Slice[uint64](m).checkZero("Filter", "AoU64").Filter(toMaybe(f)))
+}
+
+// Reject is the opposite of Filter: it returns an AoU64 of the elements of a
+// valid AoU64 for which a function returns false.
+func (m AoU64) Reject(f func(x uint64) B) AoU64 {
+	return AoU64(Slice[uint64](m).checkZero("Reject", "AoU64").Reject(toMaybe(f)))
+}
+
+// Partition splits a valid AoU64 into the elements for which a function returns
+// true and those for which it returns false.  If the AoU64 is invalid or if any
+// function returns an invalid B, both results are invalid.
+func (m AoU64) Partition(f func(x uint64) B) (AoU64, AoU64) {
+	in, out := Slice[uint64](m).checkZero("Partition", "AoU64").Partition(toMaybe(f))
+	return AoU64(in), AoU64(out)
+}
+
+// ToI converts each element of a valid AoU64 to int, resulting in an AoI.  If
+// the AoU64 is invalid or any element is out of range, ToI returns an
+// invalid AoI.
+func (m AoU64) ToI() AoI {
+	return AoI(MapSlice(Slice[uint64](m).checkZero("ToI", "AoU64"), u64ToI))
+}
+
+// ToI64 converts each element of a valid AoU64 to int64, resulting in an AoI64.
+// If the AoU64 is invalid or any element is out of range, ToI64 returns an
+// invalid AoI64.
+func (m AoU64) ToI64() AoI64 {
+	return AoI64(MapSlice(Slice[uint64](m).checkZero("ToI64", "AoU64"), u64ToI64))
+}
+
+// ToBigI converts each element of a valid AoU64 to *big.Int, resulting in an
+// AoBigI.  If the AoU64 is invalid, ToBigI returns an invalid AoBigI.
+func (m AoU64) ToBigI() AoBigI {
+	return AoBigI(MapSlice(Slice[uint64](m).checkZero("ToBigI", "AoU64"), u64ToBigI))
+}
+
+// IsNothing returns true for an AoU64 that holds nothing, i.e. one whose error
+// is ErrNothing itself.  Such an AoU64 is also invalid, so IsErr returns true.
+// An error that only wraps ErrNothing, such as an ElementError for a missing
+// element, is an ordinary error.
+func (m AoU64) IsNothing() bool {
+	return Slice[uint64](m).IsNothing()
+}
+
+// JustOr returns a valid AoU64 holding a default value if the AoU64 holds
+// nothing, or otherwise returns the AoU64 unchanged.  Unlike OrElse, it leaves
+// other errors in place.  A nil default is replaced by an empty one, as for
+// OrElse.
+func (m AoU64) JustOr(x []uint64) AoU64 {
+	return AoU64(Slice[uint64](m).JustOr(x))
+}
+
+// OrElse returns the AoU64 if it is valid, or otherwise a valid AoU64 holding a
+// default value.  A nil default is replaced by an empty one, so the result is
+// always valid.
+func (m AoU64) OrElse(x []uint64) AoU64 {
+	return AoU64(Slice[uint64](m).OrElse(x))
+}
+
+// Recover returns the AoU64 if it is valid, or otherwise a valid AoU64 holding
+// the value returned by a function that takes the error, with nil replaced by
+// an empty value as for OrElse.
+func (m AoU64) Recover(f func(err error) []uint64) AoU64 {
+	return AoU64(Slice[uint64](m).checkZero("Unbox", "AoU64").Recover(f))
+}
+
+// MapErr returns the AoU64 if it is valid, or otherwise an invalid AoU64 with
+// the error returned by a function that takes the original error, e.g. to add
+// context with fmt.Errorf and %w.  If the function returns nil, the original
+// error is kept.
+func (m AoU64) MapErr(f func(err error) error) AoU64 {
+	return AoU64(Slice[uint64](m).checkZero("Unbox", "AoU64").MapErr(f))
+}
+
+// Catch is like Recover, but only for errors that match a target according
+// to errors.Is, and the function returns an AoU64, so it may fail again.  Valid
+// values and other errors are returned unchanged.
+func (m AoU64) Catch(target error, f func(err error) AoU64) AoU64 {
+	return AoU64(Slice[uint64](m).checkZero("Unbox", "AoU64").Catch(target, toSlice(f)))
+}
+
+// MarshalJSON implements json.Marshaler using the wire format described in
+// the package documentation.
+func (m AoU64) MarshalJSON() ([]byte, error) {
+	return marshalJSON(m.Unbox())
+}
+
+// UnmarshalJSON implements json.Unmarshaler using the wire format described
+// in the package documentation.
+func (m *AoU64) UnmarshalJSON(b []byte) error {
+	return unmarshalJSON(b, (*Maybe[[]uint64])(m), "AoU64")
+}
+
+// String returns a string representation, mostly useful for debugging.
+func (m AoU64) String() string {
+	if m.IsErr() {
+		return fmt.Sprintf("Err %v", m.err)
+	}
+	return fmt.Sprintf("Just %v", m.just)
+}
+
+// Unbox returns the underlying slice of uint64s or error.
+func (m AoU64) Unbox() ([]uint64, error) {
+	if m.just == nil && m.err == nil {
+		return nil, &OpError{Op: "Unbox", Type: "AoU64", Err: ErrZeroValue}
+	}
+	return m.just, m.err
+}
@@ -0,0 +1,61 @@
+package maybe_test
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/xdg/maybe"
+	"github.com/xdg/testy"
+)
+
+func TestAoU64(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	input := []uint64{1, math.MaxUint64}
+	good := maybe.JustAoU64(input)
+	bad := maybe.ErrAoU64(errors.New("bad uint64s"))
+
+	just, err := good.Unbox()
+	is.Equal(just, input)
+	is.Nil(err)
+	is.False(good.IsErr())
+
+	just, err = bad.Unbox()
+	is.Nil(just)
+	is.Equal(err.Error(), "bad uint64s")
+	is.True(bad.IsErr())
+
+	is.Equal(maybe.NewAoU64(input, nil), good)
+	is.True(maybe.NewAoU64(nil, err).IsErr())
+	is.Equal(good.String(), "Just [1 18446744073709551615]")
+
+	zero := maybe.AoU64{}
+	is.True(zero.IsErr())
+	_, err = zero.Unbox()
+	is.NotNil(err)
+
+	_, err = good.ToI64().Unbox()
+	var ee *maybe.ElementError
+	is.True(errors.As(err, &ee))
+	is.Equal(ee.Index, []int{1})
+	is.True(errors.Is(err, strconv.ErrRange))
+	is.True(good.ToI().IsErr())
+
+	bs, err := good.ToBigI().Unbox()
+	is.Equal(bs[1].String(), "18446744073709551615")
+	is.Nil(err)
+
+	xs, err := maybe.JustAoS([]string{"7", "8"}).ToU64().Unbox()
+	is.Equal(xs, []uint64{7, 8})
+	is.Nil(err)
+
+	_, err = maybe.JustAoI([]int{7, -8}).ToU64().Unbox()
+	is.True(errors.Is(err, strconv.ErrRange))
+
+	is.True(bad.ToI().IsErr())
+	is.True(bad.ToI64().IsErr())
+	is.True(bad.ToBigI().IsErr())
+}
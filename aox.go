@@ -1,7 +1,7 @@
 package maybe
 
 import (
-	"errors"
+	"context"
 	"fmt"
 	"reflect"
 )
@@ -10,10 +10,7 @@ import (
 // considered 'valid' or 'invalid' depending on whether it contains a slice of
 // empty interfaces or an error value.  A zero-value AoX is invalid and
 // Unbox() will return an error to that effect.
-type AoX struct {
-	just []interface{}
-	err  error
-}
+type AoX Slice[interface{}]
 
 // NewAoX constructs an AoX from a given slice of empty interfaces or error.
 // If e is not nil, returns ErrAoX(e), otherwise returns JustAoX(x).
@@ -24,7 +21,7 @@ func NewAoX(x []interface{}, e error) AoX {
 	return JustAoX(x)
 }
 
-var errAoXNotSlice = errors.New("NewAoXFromSlice called with non-slice")
+var errAoXNotSlice error = &OpError{Op: "NewAoXFromSlice", Type: "AoX", Err: ErrNotSlice}
 
 // NewAoXFromSlice constructs an AoX from a given slice of arbitrary values or
 // error.  If e is not nil, returns ErrAoX(e), otherwise, the slice of values
@@ -60,6 +57,12 @@ func ErrAoX(e error) AoX {
 	return AoX{err: e}
 }
 
+// NothingAoX constructs an invalid AoX that holds nothing, for a missing rather
+// than a failed value.  See ErrNothing.
+func NothingAoX() AoX {
+	return AoX{err: ErrNothing}
+}
+
 // IsErr returns true for an invalid AoX.
 func (m AoX) IsErr() bool {
 	return m.just == nil || m.err != nil
@@ -72,57 +75,211 @@ func (m AoX) Bind(f func(x []interface{}) AoX) AoX {
 		return m
 	}
 
-	return f(m.just)
+	return try(f, m.just, ErrAoX)
+}
+
+// BindCtx is like Bind, but the function also takes a context.  If the
+// context is done, BindCtx returns an invalid AoX with the context's error
+// instead of calling the function.
+func (m AoX) BindCtx(ctx context.Context, f func(ctx context.Context, x []interface{}) AoX) AoX {
+	if m.IsErr() {
+		return m
+	}
+	if err := ctx.Err(); err != nil {
+		return ErrAoX(err)
+	}
+
+	return tryCtx(ctx, f, m.just, ErrAoX)
 }
 
 // Join applies a function that takes a slice of empty interfaces and returns
 // an I.
 func (m AoX) Join(f func(x []interface{}) X) X {
 	if m.IsErr() {
-		return ErrX(m.err)
+		return ErrX(zeroErr(m.err, "Join", "AoX"))
+	}
+
+	return try(f, m.just, ErrX)
+}
+
+// JoinCtx is like Join, but the function also takes a context.  If the
+// context is done, JoinCtx returns an invalid X with the context's error
+// instead of calling the function.
+func (m AoX) JoinCtx(ctx context.Context, f func(ctx context.Context, x []interface{}) X) X {
+	if m.IsErr() {
+		return ErrX(zeroErr(m.err, "JoinCtx", "AoX"))
+	}
+	if err := ctx.Err(); err != nil {
+		return ErrX(err)
 	}
 
-	return f(m.just)
+	return tryCtx(ctx, f, m.just, ErrX)
 }
 
 // Split applies a splitting function to each element of a valid AoX,
 // resulting in a higher-dimension structure. If the AoX is invalid or if any
 // function returns an invalid AoX, Split returns an invalid AoAoX.
 func (m AoX) Split(f func(x interface{}) AoX) AoAoX {
-	if m.IsErr() {
-		return ErrAoAoX(m.err)
-	}
-
-	xss := make([][]interface{}, len(m.just))
-	for i, v := range m.just {
-		xs, err := f(v).Unbox()
-		if err != nil {
-			return ErrAoAoX(err)
-		}
-		xss[i] = xs
-	}
+	return AoAoX(SplitSlice(Slice[interface{}](m).checkZero("Split", "AoX"), toSlice(f)))
+}
 
-	return JustAoAoX(xss)
+// SplitCtx is like Split, but the function also takes a context.  The
+// context is checked before each element; once it is done, SplitCtx stops and
+// returns an invalid AoAoX with the context's error.
+func (m AoX) SplitCtx(ctx context.Context, f func(ctx context.Context, x interface{}) AoX) AoAoX {
+	return AoAoX(SplitSliceCtx(ctx, Slice[interface{}](m).checkZero("SplitCtx", "AoX"), toSliceCtx(f)))
 }
 
 // Map applies a function to each element of a valid AoX and returns a new
 // AoX.  If the AoX is invalid or if any function returns an invalid I, Map
 // returns an invalid AoX.
 func (m AoX) Map(f func(x interface{}) X) AoX {
-	if m.IsErr() {
-		return m
-	}
+	return AoX(MapSlice(Slice[interface{}](m).checkZero("Map", "AoX"), toMaybe(f)))
+}
 
-	xss := make([]interface{}, len(m.just))
-	for i, v := range m.just {
-		x, err := f(v).Unbox()
-		if err != nil {
-			return ErrAoX(err)
-		}
-		xss[i] = x
-	}
+// Filter returns an AoX of the elements of a valid AoX for which a function
+// returns true, in order.  If the AoX is invalid or if any function returns an
+// invalid B, Filter returns an invalid AoX.
+func (m AoX) Filter(f func(x interface{}) B) AoX {
+	return AoX(Slice[interface{}](m).checkZero("Filter", "AoX").Filter(toMaybe(f)))
+}
+
+// Reject is the opposite of Filter: it returns an AoX of the elements of a
+// valid AoX for which a function returns false.
+func (m AoX) Reject(f func(x interface{}) B) AoX {
+	return AoX(Slice[interface{}](m).checkZero("Reject", "AoX").Reject(toMaybe(f)))
+}
+
+// Partition splits a valid AoX into the elements for which a function returns
+// true and those for which it returns false.  If the AoX is invalid or if any
+// function returns an invalid B, both results are invalid.
+func (m AoX) Partition(f func(x interface{}) B) (AoX, AoX) {
+	in, out := Slice[interface{}](m).checkZero("Partition", "AoX").Partition(toMaybe(f))
+	return AoX(in), AoX(out)
+}
+
+// Fold combines the elements of a valid AoX into a single X, starting from an
+// initial value and applying a function to the accumulator and each element
+// in turn.  An empty AoX results in the initial value.  If the AoX is invalid
+// or if any function returns an invalid X, Fold stops and returns an invalid
+// X.
+func (m AoX) Fold(init interface{}, f func(acc interface{}, x interface{}) X) X {
+	return X(FoldSlice(Slice[interface{}](m).checkZero("Fold", "AoX"), init, toMaybe2(f)))
+}
+
+// Scan is like Fold, but returns an AoX of the accumulator after each
+// element, e.g. running totals.  If the AoX is invalid or if any function
+// returns an invalid X, Scan returns an invalid AoX.
+func (m AoX) Scan(init interface{}, f func(acc interface{}, x interface{}) X) AoX {
+	return AoX(ScanSlice(Slice[interface{}](m).checkZero("Scan", "AoX"), init, toMaybe2(f)))
+}
+
+// Zip pairs up the elements of two valid AoXs of the same length, resulting
+// in an AoAoX with a row of two elements for each pair.  If either AoX is
+// invalid, Zip returns an invalid AoAoX with the error of the first invalid
+// one; if their lengths differ, the error wraps ErrLengthMismatch.
+func (m AoX) Zip(other AoX) AoAoX {
+	return AoAoX(Slice[interface{}](m).checkZero("Zip", "AoX").Zip(Slice[interface{}](other).checkZero("Zip", "AoX")))
+}
+
+// ZipWith applies a function to each pair of elements of two valid AoXs of
+// the same length and returns a new AoX.  It fails as Zip does, or if any
+// function returns an invalid X.
+func (m AoX) ZipWith(other AoX, f func(x, y interface{}) X) AoX {
+	return AoX(ZipSliceWith(Slice[interface{}](m).checkZero("ZipWith", "AoX"), Slice[interface{}](other).checkZero("ZipWith", "AoX"), toMaybe2(f)))
+}
+
+// MapCtx is like Map, but the function also takes a context.  The context is
+// checked before each element; once it is done, MapCtx stops and returns an
+// invalid AoX with the context's error.
+func (m AoX) MapCtx(ctx context.Context, f func(ctx context.Context, x interface{}) X) AoX {
+	return AoX(MapSliceCtx(ctx, Slice[interface{}](m).checkZero("MapCtx", "AoX"), toMaybeCtx(f)))
+}
+
+// MapAll is like Map, but applies the function to every element even after
+// a failure.  If any function returns an invalid X, MapAll returns an
+// invalid AoX whose error joins the errors from all failing elements, each
+// tagged with its index.
+func (m AoX) MapAll(f func(x interface{}) X) AoX {
+	return AoX(MapSliceAll(Slice[interface{}](m).checkZero("MapAll", "AoX"), toMaybe(f)))
+}
+
+// MapResults applies a function to each element of a valid AoX and keeps the
+// outcome for every element, so that the valid results can be used and the
+// failures reported.  If the AoX is invalid, MapResults returns an invalid
+// Results.
+func (m AoX) MapResults(f func(x interface{}) X) Results[interface{}] {
+	return MapResults(Slice[interface{}](m).checkZero("MapResults", "AoX"), toMaybe(f))
+}
+
+// ParallelMap is like Map, but runs the function on up to n elements
+// concurrently, or GOMAXPROCS elements if n is less than 1.  The order of
+// elements is preserved.  Once a function returns an invalid X, no further
+// elements are started and ParallelMap returns an invalid AoX.
+func (m AoX) ParallelMap(n int, f func(x interface{}) X) AoX {
+	return AoX(ParallelMapSlice(Slice[interface{}](m).checkZero("ParallelMap", "AoX"), n, toMaybe(f)))
+}
+
+// Seq returns a lazy sequence of the elements of the AoX.  If the AoX is
+// invalid, the sequence yields only its error.
+func (m AoX) Seq() Seq[interface{}] {
+	return Slice[interface{}](m).checkZero("Seq", "AoX").Seq()
+}
+
+// IsNothing returns true for an AoX that holds nothing, i.e. one whose error is
+// ErrNothing itself.  Such an AoX is also invalid, so IsErr returns true.  An
+// error that only wraps ErrNothing, such as an ElementError for a missing
+// element, is an ordinary error.
+func (m AoX) IsNothing() bool {
+	return Slice[interface{}](m).IsNothing()
+}
+
+// JustOr returns a valid AoX holding a default value if the AoX holds nothing,
+// or otherwise returns the AoX unchanged.  Unlike OrElse, it leaves other
+// errors in place.  A nil default is replaced by an empty one, as for OrElse.
+func (m AoX) JustOr(x []interface{}) AoX {
+	return AoX(Slice[interface{}](m).JustOr(x))
+}
+
+// OrElse returns the AoX if it is valid, or otherwise a valid AoX holding a
+// default value.  A nil default is replaced by an empty one, so the result is
+// always valid.
+func (m AoX) OrElse(x []interface{}) AoX {
+	return AoX(Slice[interface{}](m).OrElse(x))
+}
+
+// Recover returns the AoX if it is valid, or otherwise a valid AoX holding the
+// value returned by a function that takes the error, with nil replaced by an
+// empty value as for OrElse.
+func (m AoX) Recover(f func(err error) []interface{}) AoX {
+	return AoX(Slice[interface{}](m).checkZero("Unbox", "AoX").Recover(f))
+}
+
+// MapErr returns the AoX if it is valid, or otherwise an invalid AoX with the
+// error returned by a function that takes the original error, e.g. to add
+// context with fmt.Errorf and %w.  If the function returns nil, the original
+// error is kept.
+func (m AoX) MapErr(f func(err error) error) AoX {
+	return AoX(Slice[interface{}](m).checkZero("Unbox", "AoX").MapErr(f))
+}
+
+// Catch is like Recover, but only for errors that match a target according
+// to errors.Is, and the function returns an AoX, so it may fail again.  Valid
+// values and other errors are returned unchanged.
+func (m AoX) Catch(target error, f func(err error) AoX) AoX {
+	return AoX(Slice[interface{}](m).checkZero("Unbox", "AoX").Catch(target, toSlice(f)))
+}
+
+// MarshalJSON implements json.Marshaler using the wire format described in
+// the package documentation.
+func (m AoX) MarshalJSON() ([]byte, error) {
+	return marshalJSON(m.Unbox())
+}
 
-	return JustAoX(xss)
+// UnmarshalJSON implements json.Unmarshaler using the wire format described
+// in the package documentation.
+func (m *AoX) UnmarshalJSON(b []byte) error {
+	return unmarshalJSON(b, (*Maybe[[]interface{}])(m), "AoX")
 }
 
 // String returns a string representation, mostly useful for debugging.
@@ -136,7 +293,7 @@ func (m AoX) String() string {
 // Unbox returns the underlying slice of empty interfaces or error.
 func (m AoX) Unbox() ([]interface{}, error) {
 	if m.just == nil && m.err == nil {
-		return nil, errors.New("zero-value AoX")
+		return nil, &OpError{Op: "Unbox", Type: "AoX", Err: ErrZeroValue}
 	}
 	return m.just, m.err
 }
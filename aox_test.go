@@ -192,3 +192,44 @@ func TestAoXMap(t *testing.T) {
 	negBadMap := good.Map(func(x interface{}) maybe.X { return maybe.ErrX(errors.New("bad interface{}")) })
 	is.True(negBadMap.IsErr())
 }
+
+func TestAoXFold(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	count := func(acc, x interface{}) maybe.X {
+		if x == nil {
+			return maybe.ErrX(errors.New("nil element"))
+		}
+		return maybe.JustX(acc.(int) + 1)
+	}
+	good := maybe.JustAoX([]interface{}{"a", 1, 2.5})
+
+	is.Equal(good.Fold(0, count), maybe.JustX(3))
+	is.Equal(good.Scan(0, count), maybe.JustAoX([]interface{}{1, 2, 3}))
+
+	_, err := maybe.JustAoX([]interface{}{"a", nil}).Fold(0, count).Unbox()
+	is.Equal(err.Error(), "element [1]: nil element")
+	is.True(maybe.ErrAoX(errors.New("bad")).Fold(0, count).IsErr())
+}
+
+func TestAoXZip(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	xs := maybe.JustAoX([]interface{}{1, "a"})
+	ys := maybe.JustAoX([]interface{}{true, 2.5})
+
+	pairs := xs.Zip(ys)
+	is.Equal(pairs, maybe.JustAoAoX([][]interface{}{{1, true}, {"a", 2.5}}))
+	a, b := pairs.Unzip()
+	is.Equal(a, xs)
+	is.Equal(b, ys)
+
+	pair := func(x, y interface{}) maybe.X { return maybe.JustX([2]interface{}{x, y}) }
+	is.Equal(xs.ZipWith(ys, pair), maybe.JustAoX([]interface{}{[2]interface{}{1, true}, [2]interface{}{"a", 2.5}}))
+
+	_, err := xs.Zip(maybe.JustAoX([]interface{}{})).Unbox()
+	is.True(errors.Is(err, maybe.ErrLengthMismatch))
+	is.True(maybe.ErrAoX(errors.New("bad")).ZipWith(ys, pair).IsErr())
+}
@@ -0,0 +1,120 @@
+package maybe
+
+import "fmt"
+
+// B implements the Maybe monad for a bool.  A B is considered 'valid' or
+// 'invalid' depending on whether it contains a bool or an error value.
+type B Maybe[bool]
+
+// NewB constructs a B from a given bool or error. If e is not nil, returns
+// ErrB(e), otherwise returns JustB(x).
+func NewB(x bool, e error) B {
+	if e != nil {
+		return ErrB(e)
+	}
+	return JustB(x)
+}
+
+// JustB constructs a valid B from a given bool.
+func JustB(x bool) B {
+	return B{just: x}
+}
+
+// ErrB constructs an invalid B from a given error.
+func ErrB(e error) B {
+	return B{err: e}
+}
+
+// NothingB constructs an invalid B that holds nothing, for a missing rather
+// than a failed value.  See ErrNothing.
+func NothingB() B {
+	return B{err: ErrNothing}
+}
+
+// IsErr returns true for an invalid B.
+func (m B) IsErr() bool {
+	return m.err != nil
+}
+
+// Bind applies a function that takes a bool and returns a B.
+func (m B) Bind(f func(x bool) B) B {
+	if m.err != nil {
+		return m
+	}
+
+	return try(f, m.just, ErrB)
+}
+
+// Split applies a function that takes a bool and returns an AoB.
+func (m B) Split(f func(x bool) AoB) AoB {
+	if m.err != nil {
+		return ErrAoB(m.err)
+	}
+
+	return try(f, m.just, ErrAoB)
+}
+
+// IsNothing returns true for a B that holds nothing, i.e. one whose error is
+// ErrNothing itself.  Such a B is also invalid, so IsErr returns true.
+func (m B) IsNothing() bool {
+	return Maybe[bool](m).IsNothing()
+}
+
+// JustOr returns a valid B holding a default value if the B holds nothing, or
+// otherwise returns the B unchanged.  Unlike OrElse, it leaves other errors in
+// place.
+func (m B) JustOr(x bool) B {
+	return B(Maybe[bool](m).JustOr(x))
+}
+
+// OrElse returns the B if it is valid, or otherwise a valid B holding a default
+// value.
+func (m B) OrElse(x bool) B {
+	return B(Maybe[bool](m).OrElse(x))
+}
+
+// Recover returns the B if it is valid, or otherwise a valid B holding the
+// value returned by a function that takes the error.
+func (m B) Recover(f func(err error) bool) B {
+	return B(Maybe[bool](m).Recover(f))
+}
+
+// MapErr returns the B if it is valid, or otherwise an invalid B with the
+// error returned by a function that takes the original error, e.g. to add
+// context with fmt.Errorf and %w.  If the function returns nil, the original
+// error is kept.
+func (m B) MapErr(f func(err error) error) B {
+	return B(Maybe[bool](m).MapErr(f))
+}
+
+// Catch is like Recover, but only for errors that match a target according
+// to errors.Is, and the function returns a B, so it may fail again.  Valid
+// values and other errors are returned unchanged.
+func (m B) Catch(target error, f func(err error) B) B {
+	return B(Maybe[bool](m).Catch(target, toMaybe(f)))
+}
+
+// MarshalJSON implements json.Marshaler using the wire format described in
+// the package documentation.
+func (m B) MarshalJSON() ([]byte, error) {
+	return marshalJSON(m.Unbox())
+}
+
+// UnmarshalJSON implements json.Unmarshaler using the wire format described
+// in the package documentation.
+func (m *B) UnmarshalJSON(b []byte) error {
+	return unmarshalJSON(b, (*Maybe[bool])(m), "B")
+}
+
+// String returns a string representation, mostly useful for debugging.
+func (m B) String() string {
+	if m.err != nil {
+		return fmt.Sprintf("Err %v", m.err)
+	}
+	return fmt.Sprintf("Just %v", m.just)
+}
+
+// Unbox returns the underlying bool value or error.
+func (m B) Unbox() (bool, error) {
+	return m.just, m.err
+}
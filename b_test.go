@@ -0,0 +1,72 @@
+package maybe_test
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/xdg/maybe"
+	"github.com/xdg/testy"
+)
+
+func TestBool(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	var good, bad, got maybe.B
+	var just bool
+	var err error
+
+	good = maybe.JustB(true)
+	just, err = good.Unbox()
+	is.Equal(just, true)
+	is.Nil(err)
+	is.False(good.IsErr())
+
+	bad = maybe.ErrB(errors.New("bad bool"))
+	just, err = bad.Unbox()
+	is.Equal(just, false)
+	is.NotNil(err)
+	is.Equal(err.Error(), "bad bool")
+	is.True(bad.IsErr())
+
+	got = maybe.NewB(true, nil)
+	is.Equal(got, good)
+
+	got = maybe.NewB(false, err)
+	is.True(got.IsErr())
+
+	is.Equal(good.String(), "Just true")
+	is.Equal(bad.String(), "Err bad bool")
+
+	not := func(x bool) maybe.B { return maybe.JustB(!x) }
+	just, err = good.Bind(not).Unbox()
+	is.Equal(just, false)
+	is.Nil(err)
+	is.True(bad.Bind(not).IsErr())
+
+	both := func(x bool) maybe.AoB { return maybe.JustAoB([]bool{x, !x}) }
+	xs, err := good.Split(both).Unbox()
+	is.Equal(xs, []bool{true, false})
+	is.Nil(err)
+	is.True(bad.Split(both).IsErr())
+}
+
+func TestBoolConversions(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	parse := func(s string) maybe.B { return maybe.NewB(strconv.ParseBool(s)) }
+	even := func(x int) maybe.B { return maybe.JustB(x%2 == 0) }
+
+	just, err := maybe.JustS("true").ToBool(parse).Unbox()
+	is.Equal(just, true)
+	is.Nil(err)
+	is.True(maybe.JustS("yes please").ToBool(parse).IsErr())
+	is.True(maybe.ErrS(errors.New("bad string")).ToBool(parse).IsErr())
+
+	just, err = maybe.JustI(3).ToBool(even).Unbox()
+	is.Equal(just, false)
+	is.Nil(err)
+	is.True(maybe.ErrI(errors.New("bad int")).ToBool(even).IsErr())
+}
@@ -0,0 +1,155 @@
+package maybe
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// BigI implements the Maybe monad for a *big.Int.  A BigI is considered 'valid'
+// or 'invalid' depending on whether it contains a *big.Int or an error value.
+// As with any *big.Int, functions passed to Bind and similar methods should
+// compute into a new big.Int rather than modify their argument in place.
+type BigI Maybe[*big.Int]
+
+// NewBigI constructs a BigI from a given *big.Int or error. If e is not nil,
+// returns ErrBigI(e), otherwise returns JustBigI(x).
+func NewBigI(x *big.Int, e error) BigI {
+	if e != nil {
+		return ErrBigI(e)
+	}
+	return JustBigI(x)
+}
+
+// JustBigI constructs a valid BigI from a given *big.Int.
+func JustBigI(x *big.Int) BigI {
+	return BigI{just: x}
+}
+
+// ErrBigI constructs an invalid BigI from a given error.
+func ErrBigI(e error) BigI {
+	return BigI{err: e}
+}
+
+// NothingBigI constructs an invalid BigI that holds nothing, for a missing
+// rather than a failed value.  See ErrNothing.
+func NothingBigI() BigI {
+	return BigI{err: ErrNothing}
+}
+
+// IsErr returns true for an invalid BigI.
+func (m BigI) IsErr() bool {
+	return m.err != nil
+}
+
+// Bind applies a function that takes a *big.Int and returns a BigI.
+func (m BigI) Bind(f func(x *big.Int) BigI) BigI {
+	if m.err != nil {
+		return m
+	}
+
+	return try(f, m.just, ErrBigI)
+}
+
+// Split applies a function that takes a *big.Int and returns an AoBigI.
+func (m BigI) Split(f func(x *big.Int) AoBigI) AoBigI {
+	if m.err != nil {
+		return ErrAoBigI(m.err)
+	}
+
+	return try(f, m.just, ErrAoBigI)
+}
+
+// ToI converts a valid BigI to an I.  If the BigI is invalid or its value is
+// out of range for int, ToI returns an invalid I.
+func (m BigI) ToI() I {
+	if m.err != nil {
+		return ErrI(m.err)
+	}
+
+	return I(bigIToI(m.just))
+}
+
+// ToI64 converts a valid BigI to an I64.  If the BigI is invalid or its value
+// is out of range for int64, ToI64 returns an invalid I64.
+func (m BigI) ToI64() I64 {
+	if m.err != nil {
+		return ErrI64(m.err)
+	}
+
+	return I64(bigIToI64(m.just))
+}
+
+// ToU64 converts a valid BigI to a U64.  If the BigI is invalid or its value is
+// out of range for uint64, ToU64 returns an invalid U64.
+func (m BigI) ToU64() U64 {
+	if m.err != nil {
+		return ErrU64(m.err)
+	}
+
+	return U64(bigIToU64(m.just))
+}
+
+// IsNothing returns true for a BigI that holds nothing, i.e. one whose error is
+// ErrNothing itself.  Such a BigI is also invalid, so IsErr returns true.
+func (m BigI) IsNothing() bool {
+	return Maybe[*big.Int](m).IsNothing()
+}
+
+// JustOr returns a valid BigI holding a default value if the BigI holds
+// nothing, or otherwise returns the BigI unchanged.  Unlike OrElse, it leaves
+// other errors in place.
+func (m BigI) JustOr(x *big.Int) BigI {
+	return BigI(Maybe[*big.Int](m).JustOr(x))
+}
+
+// OrElse returns the BigI if it is valid, or otherwise a valid BigI holding a
+// default value.
+func (m BigI) OrElse(x *big.Int) BigI {
+	return BigI(Maybe[*big.Int](m).OrElse(x))
+}
+
+// Recover returns the BigI if it is valid, or otherwise a valid BigI holding
+// the value returned by a function that takes the error.
+func (m BigI) Recover(f func(err error) *big.Int) BigI {
+	return BigI(Maybe[*big.Int](m).Recover(f))
+}
+
+// MapErr returns the BigI if it is valid, or otherwise an invalid BigI with the
+// error returned by a function that takes the original error, e.g. to add
+// context with fmt.Errorf and %w.  If the function returns nil, the original
+// error is kept.
+func (m BigI) MapErr(f func(err error) error) BigI {
+	return BigI(Maybe[*big.Int](m).MapErr(f))
+}
+
+// Catch is like Recover, but only for errors that match a target according
+// to errors.Is, and the function returns a BigI, so it may fail again.  Valid
+// values and other errors are returned unchanged.
+func (m BigI) Catch(target error, f func(err error) BigI) BigI {
+	return BigI(Maybe[*big.Int](m).Catch(target, toMaybe(f)))
+}
+
+// MarshalJSON implements json.Marshaler using the wire format described in
+// the package documentation.
+func (m BigI) MarshalJSON() ([]byte, error) {
+	return marshalJSON(m.Unbox())
+}
+
+// UnmarshalJSON implements json.Unmarshaler using the wire format described
+// in the package documentation.
+func (m *BigI) UnmarshalJSON(b []byte) error {
+	return unmarshalJSON(b, (*Maybe[*big.Int])(m), "BigI")
+}
+
+// String returns a string representation, mostly useful for debugging.
+func (m BigI) String() string {
+	if m.err != nil {
+		return fmt.Sprintf("Err %v", m.err)
+	}
+	return fmt.Sprintf("Just %v", m.just)
+}
+
+// Unbox returns the underlying *big.Int value or error.
+func (m BigI) Unbox() (*big.Int, error) {
+	return m.just, m.err
+}
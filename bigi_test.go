@@ -0,0 +1,90 @@
+package maybe_test
+
+import (
+	"errors"
+	"math/big"
+	"strconv"
+	"testing"
+
+	"github.com/xdg/maybe"
+	"github.com/xdg/testy"
+)
+
+func TestBigInt(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	input := big.NewInt(42)
+	good := maybe.JustBigI(input)
+	bad := maybe.ErrBigI(errors.New("bad big int"))
+
+	just, err := good.Unbox()
+	is.Equal(just, input)
+	is.Nil(err)
+	is.False(good.IsErr())
+
+	just, err = bad.Unbox()
+	is.Nil(just)
+	is.Equal(err.Error(), "bad big int")
+	is.True(bad.IsErr())
+
+	is.Equal(maybe.NewBigI(input, nil), good)
+	is.True(maybe.NewBigI(nil, err).IsErr())
+
+	is.Equal(good.String(), "Just 42")
+	is.Equal(bad.String(), "Err bad big int")
+
+	square := func(x *big.Int) maybe.BigI { return maybe.JustBigI(new(big.Int).Mul(x, x)) }
+	just, err = good.Bind(square).Unbox()
+	is.Equal(just.String(), "1764")
+	is.Nil(err)
+	is.Equal(input.String(), "42")
+	is.True(bad.Bind(square).IsErr())
+}
+
+func TestBigIntConversions(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	huge := maybe.JustS("123456789012345678901234567890").ToBigI()
+	just, err := huge.Unbox()
+	is.Equal(just.String(), "123456789012345678901234567890")
+	is.Nil(err)
+
+	_, err = maybe.JustS("12ab").ToBigI().Unbox()
+	is.True(errors.Is(err, strconv.ErrSyntax))
+	is.True(maybe.ErrS(errors.New("bad string")).ToBigI().IsErr())
+
+	_, err = huge.ToI64().Unbox()
+	is.True(errors.Is(err, strconv.ErrRange))
+	_, err = huge.ToU64().Unbox()
+	is.True(errors.Is(err, strconv.ErrRange))
+	_, err = huge.ToI().Unbox()
+	is.True(errors.Is(err, strconv.ErrRange))
+
+	neg := maybe.JustS("-5").ToBigI()
+	i, err := neg.ToI().Unbox()
+	is.Equal(i, -5)
+	is.Nil(err)
+	i64, err := neg.ToI64().Unbox()
+	is.Equal(i64, int64(-5))
+	is.Nil(err)
+	_, err = neg.ToU64().Unbox()
+	is.True(errors.Is(err, strconv.ErrRange))
+
+	u, err := maybe.JustS("18446744073709551615").ToBigI().ToU64().Unbox()
+	is.Equal(u, uint64(18446744073709551615))
+	is.Nil(err)
+
+	is.True(maybe.JustBigI(nil).ToI64().IsErr())
+
+	b, err := maybe.JustI(-7).ToBigI().Unbox()
+	is.Equal(b.Int64(), int64(-7))
+	is.Nil(err)
+	is.True(maybe.ErrI(errors.New("bad int")).ToBigI().IsErr())
+
+	bad := maybe.ErrBigI(errors.New("bad big int"))
+	is.True(bad.ToI().IsErr())
+	is.True(bad.ToI64().IsErr())
+	is.True(bad.ToU64().IsErr())
+}
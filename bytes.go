@@ -0,0 +1,316 @@
+package maybe
+
+import "fmt"
+
+// Bytes implements the Maybe monad for a byte slice.  A Bytes is considered
+// 'valid' or 'invalid' depending on whether it contains a byte slice or an
+// error value.  As with the other slice types, a zero-value Bytes is invalid
+// and Unbox() will return an error to that effect, so use an empty, non-nil
+// slice for no data.
+type Bytes Slice[byte]
+
+// NewBytes constructs a Bytes from a given byte slice or error. If e is not
+// nil, returns ErrBytes(e), otherwise returns JustBytes(x).
+func NewBytes(x []byte, e error) Bytes {
+	if e != nil {
+		return ErrBytes(e)
+	}
+	return JustBytes(x)
+}
+
+// JustBytes constructs a valid Bytes from a given byte slice.
+func JustBytes(x []byte) Bytes {
+	return Bytes{just: x}
+}
+
+// ErrBytes constructs an invalid Bytes from a given error.
+func ErrBytes(e error) Bytes {
+	return Bytes{err: e}
+}
+
+// NothingBytes constructs an invalid Bytes that holds nothing, for a missing
+// rather than a failed value.  See ErrNothing.
+func NothingBytes() Bytes {
+	return Bytes{err: ErrNothing}
+}
+
+// IsErr returns true for an invalid Bytes.
+func (m Bytes) IsErr() bool {
+	return m.just == nil || m.err != nil
+}
+
+// Bind applies a function that takes a byte slice and returns a Bytes.
+func (m Bytes) Bind(f func(x []byte) Bytes) Bytes {
+	if m.IsErr() {
+		return m
+	}
+
+	return try(f, m.just, ErrBytes)
+}
+
+// Split applies a function that takes a byte slice and returns an AoBytes,
+// e.g. to split a buffer into lines.  If the Bytes is invalid, Split returns
+// an invalid AoBytes.
+func (m Bytes) Split(f func(x []byte) AoBytes) AoBytes {
+	if m.IsErr() {
+		return ErrAoBytes(zeroErr(m.err, "Split", "Bytes"))
+	}
+
+	return try(f, m.just, ErrAoBytes)
+}
+
+// ToStr converts a valid Bytes to an S.  If the Bytes is invalid, ToStr
+// returns an invalid S.
+func (m Bytes) ToStr() S {
+	if m.IsErr() {
+		return ErrS(zeroErr(m.err, "ToStr", "Bytes"))
+	}
+
+	return JustS(string(m.just))
+}
+
+// IsNothing returns true for a Bytes that holds nothing, i.e. one whose error
+// is ErrNothing itself.  Such a Bytes is also invalid, so IsErr returns true.
+// An error that only wraps ErrNothing, such as an ElementError for a missing
+// element, is an ordinary error.
+func (m Bytes) IsNothing() bool {
+	return Slice[byte](m).IsNothing()
+}
+
+// JustOr returns a valid Bytes holding a default value if the Bytes holds
+// nothing, or otherwise returns the Bytes unchanged.  Unlike OrElse, it leaves
+// other errors in place.  A nil default is replaced by an empty one, as for
+// OrElse.
+func (m Bytes) JustOr(x []byte) Bytes {
+	return Bytes(Slice[byte](m).JustOr(x))
+}
+
+// OrElse returns the Bytes if it is valid, or otherwise a valid Bytes holding a
+// default value.  A nil default is replaced by an empty one, so the result is
+// always valid.
+func (m Bytes) OrElse(x []byte) Bytes {
+	return Bytes(Slice[byte](m).OrElse(x))
+}
+
+// Recover returns the Bytes if it is valid, or otherwise a valid Bytes holding
+// the value returned by a function that takes the error, with nil replaced by
+// an empty value as for OrElse.
+func (m Bytes) Recover(f func(err error) []byte) Bytes {
+	return Bytes(Slice[byte](m).checkZero("Unbox", "Bytes").Recover(f))
+}
+
+// MapErr returns the Bytes if it is valid, or otherwise an invalid Bytes with
+// the error returned by a function that takes the original error, e.g. to add
+// context with fmt.Errorf and %w.  If the function returns nil, the original
+// error is kept.
+func (m Bytes) MapErr(f func(err error) error) Bytes {
+	return Bytes(Slice[byte](m).checkZero("Unbox", "Bytes").MapErr(f))
+}
+
+// Catch is like Recover, but only for errors that match a target according
+// to errors.Is, and the function returns a Bytes, so it may fail again.  Valid
+// values and other errors are returned unchanged.
+func (m Bytes) Catch(target error, f func(err error) Bytes) Bytes {
+	return Bytes(Slice[byte](m).checkZero("Unbox", "Bytes").Catch(target, toSlice(f)))
+}
+
+// MarshalJSON implements json.Marshaler using the wire format described in
+// the package documentation.
+func (m Bytes) MarshalJSON() ([]byte, error) {
+	return marshalJSON(m.Unbox())
+}
+
+// UnmarshalJSON implements json.Unmarshaler using the wire format described
+// in the package documentation.
+func (m *Bytes) UnmarshalJSON(b []byte) error {
+	return unmarshalJSON(b, (*Maybe[[]byte])(m), "Bytes")
+}
+
+// String returns a string representation, mostly useful for debugging.
+func (m Bytes) String() string {
+	if m.IsErr() {
+		return fmt.Sprintf("Err %v", m.err)
+	}
+	return fmt.Sprintf("Just %v", m.just)
+}
+
+// Unbox returns the underlying byte slice or error.
+func (m Bytes) Unbox() ([]byte, error) {
+	if m.just == nil && m.err == nil {
+		return nil, &OpError{Op: "Unbox", Type: "Bytes", Err: ErrZeroValue}
+	}
+	return m.just, m.err
+}
+
+// AoBytes implements the Maybe monad for a slice of byte slices.  An AoBytes
+// is considered 'valid' or 'invalid' depending on whether it contains a slice
+// of byte slices or an error value.  A zero-value AoBytes is invalid and
+// Unbox() will return an error to that effect.
+type AoBytes Grid[byte]
+
+// NewAoBytes constructs an AoBytes from a given slice of byte slices or
+// error. If e is not nil, returns ErrAoBytes(e), otherwise returns
+// JustAoBytes(x).
+func NewAoBytes(x [][]byte, e error) AoBytes {
+	if e != nil {
+		return ErrAoBytes(e)
+	}
+	return JustAoBytes(x)
+}
+
+// JustAoBytes constructs a valid AoBytes from a given slice of byte slices.
+func JustAoBytes(x [][]byte) AoBytes {
+	return AoBytes{just: x}
+}
+
+// ErrAoBytes constructs an invalid AoBytes from a given error.
+func ErrAoBytes(e error) AoBytes {
+	return AoBytes{err: e}
+}
+
+// NothingAoBytes constructs an invalid AoBytes that holds nothing, for a
+// missing rather than a failed value.  See ErrNothing.
+func NothingAoBytes() AoBytes {
+	return AoBytes{err: ErrNothing}
+}
+
+// IsErr returns true for an invalid AoBytes.
+func (m AoBytes) IsErr() bool {
+	return m.just == nil || m.err != nil
+}
+
+// Bind applies a function that takes a slice of byte slices and returns an
+// AoBytes.
+func (m AoBytes) Bind(f func(x [][]byte) AoBytes) AoBytes {
+	if m.IsErr() {
+		return m
+	}
+
+	return try(f, m.just, ErrAoBytes)
+}
+
+// Join applies a function that takes a slice of byte slices and returns a
+// Bytes.
+func (m AoBytes) Join(f func(x [][]byte) Bytes) Bytes {
+	if m.IsErr() {
+		return ErrBytes(zeroErr(m.err, "Join", "AoBytes"))
+	}
+
+	return try(f, m.just, ErrBytes)
+}
+
+// Map applies a function to each byte slice of a valid AoBytes and returns a
+// new AoBytes.  If the AoBytes is invalid or if any function returns an
+// invalid Bytes, Map returns an invalid AoBytes.
+func (m AoBytes) Map(f func(x []byte) Bytes) AoBytes {
+	return AoBytes(MapGrid(Grid[byte](m).checkZero("Map", "AoBytes"), toSlice(f)))
+}
+
+// Filter returns an AoBytes of the rows of a valid AoBytes for which a function
+// returns true, in order.  If the AoBytes is invalid or if any function returns
+// an invalid B, Filter returns an invalid AoBytes.
+func (m AoBytes) Filter(f func(x []byte) B) AoBytes {
+	return AoBytes(Grid[byte](m).checkZero("Filter", "AoBytes").Filter(toMaybe(f)))
+}
+
+// Reject is the opposite of Filter: it returns an AoBytes of the rows of a
+// valid AoBytes for which a function returns false.
+func (m AoBytes) Reject(f func(x []byte) B) AoBytes {
+	return AoBytes(Grid[byte](m).checkZero("Reject", "AoBytes").Reject(toMaybe(f)))
+}
+
+// Partition splits a valid AoBytes into the rows for which a function returns
+// true and those for which it returns false.  If the AoBytes is invalid or if
+// any function returns an invalid B, both results are invalid.
+func (m AoBytes) Partition(f func(x []byte) B) (AoBytes, AoBytes) {
+	in, out := Grid[byte](m).checkZero("Partition", "AoBytes").Partition(toMaybe(f))
+	return AoBytes(in), AoBytes(out)
+}
+
+// ToStr converts each byte slice of a valid AoBytes to a string, resulting
+// in an AoS.  If the AoBytes is invalid, ToStr returns an invalid AoS.
+func (m AoBytes) ToStr() AoS {
+	if m.IsErr() {
+		return ErrAoS(zeroErr(m.err, "ToStr", "AoBytes"))
+	}
+
+	xs := make([]string, len(m.just))
+	for i, v := range m.just {
+		xs[i] = string(v)
+	}
+
+	return JustAoS(xs)
+}
+
+// IsNothing returns true for an AoBytes that holds nothing, i.e. one whose
+// error is ErrNothing itself.  Such an AoBytes is also invalid, so IsErr
+// returns true.  An error that only wraps ErrNothing, such as an ElementError
+// for a missing element, is an ordinary error.
+func (m AoBytes) IsNothing() bool {
+	return Grid[byte](m).IsNothing()
+}
+
+// JustOr returns a valid AoBytes holding a default value if the AoBytes holds
+// nothing, or otherwise returns the AoBytes unchanged.  Unlike OrElse, it
+// leaves other errors in place.  A nil default is replaced by an empty one, as
+// for OrElse.
+func (m AoBytes) JustOr(x [][]byte) AoBytes {
+	return AoBytes(Grid[byte](m).JustOr(x))
+}
+
+// OrElse returns the AoBytes if it is valid, or otherwise a valid AoBytes
+// holding a default value.  A nil default is replaced by an empty one, so the
+// result is always valid.
+func (m AoBytes) OrElse(x [][]byte) AoBytes {
+	return AoBytes(Grid[byte](m).OrElse(x))
+}
+
+// Recover returns the AoBytes if it is valid, or otherwise a valid AoBytes
+// holding the value returned by a function that takes the error, with nil
+// replaced by an empty value as for OrElse.
+func (m AoBytes) Recover(f func(err error) [][]byte) AoBytes {
+	return AoBytes(Grid[byte](m).checkZero("Unbox", "AoBytes").Recover(f))
+}
+
+// MapErr returns the AoBytes if it is valid, or otherwise an invalid AoBytes
+// with the error returned by a function that takes the original error, e.g. to
+// add context with fmt.Errorf and %w.  If the function returns nil, the
+// original error is kept.
+func (m AoBytes) MapErr(f func(err error) error) AoBytes {
+	return AoBytes(Grid[byte](m).checkZero("Unbox", "AoBytes").MapErr(f))
+}
+
+// Catch is like Recover, but only for errors that match a target according to
+// errors.Is, and the function returns an AoBytes, so it may fail again.  Valid
+// values and other errors are returned unchanged.
+func (m AoBytes) Catch(target error, f func(err error) AoBytes) AoBytes {
+	return AoBytes(Grid[byte](m).checkZero("Unbox", "AoBytes").Catch(target, toGrid(f)))
+}
+
+// MarshalJSON implements json.Marshaler using the wire format described in
+// the package documentation.
+func (m AoBytes) MarshalJSON() ([]byte, error) {
+	return marshalJSON(m.Unbox())
+}
+
+// UnmarshalJSON implements json.Unmarshaler using the wire format described
+// in the package documentation.
+func (m *AoBytes) UnmarshalJSON(b []byte) error {
+	return unmarshalJSON(b, (*Maybe[[][]byte])(m), "AoBytes")
+}
+
+// String returns a string representation, mostly useful for debugging.
+func (m AoBytes) String() string {
+	if m.IsErr() {
+		return fmt.Sprintf("Err %v", m.err)
+	}
+	return fmt.Sprintf("Just %v", m.just)
+}
+
+// Unbox returns the underlying slice of byte slices or error.
+func (m AoBytes) Unbox() ([][]byte, error) {
+	if m.just == nil && m.err == nil {
+		return nil, &OpError{Op: "Unbox", Type: "AoBytes", Err: ErrZeroValue}
+	}
+	return m.just, m.err
+}
@@ -0,0 +1,94 @@
+package maybe_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/xdg/maybe"
+	"github.com/xdg/testy"
+)
+
+func TestBytes(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	input := []byte("hi")
+	good := maybe.JustBytes(input)
+	bad := maybe.ErrBytes(errors.New("bad bytes"))
+	var just []byte
+	var err error
+
+	just, err = good.Unbox()
+	is.Equal(just, input)
+	is.Nil(err)
+	is.False(good.IsErr())
+
+	just, err = bad.Unbox()
+	is.Nil(just)
+	is.Equal(err.Error(), "bad bytes")
+	is.True(bad.IsErr())
+
+	is.Equal(maybe.NewBytes(input, nil), good)
+	is.True(maybe.NewBytes(nil, err).IsErr())
+
+	is.Equal(good.String(), "Just [104 105]")
+	is.Equal(bad.String(), "Err bad bytes")
+
+	zero := maybe.Bytes{}
+	is.True(zero.IsErr())
+	_, err = zero.Unbox()
+	is.NotNil(err)
+
+	s, err := maybe.JustS("héllo").ToBytes().Bind(func(x []byte) maybe.Bytes {
+		return maybe.JustBytes(bytes.ToUpper(x))
+	}).ToStr().Unbox()
+	is.Equal(s, "HÉLLO")
+	is.Nil(err)
+	is.True(maybe.ErrS(errors.New("bad string")).ToBytes().IsErr())
+	is.True(bad.ToStr().IsErr())
+}
+
+func TestAoBytes(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	lines := func(x []byte) maybe.AoBytes {
+		return maybe.JustAoBytes(bytes.Split(x, []byte("\n")))
+	}
+	trim := func(x []byte) maybe.Bytes { return maybe.JustBytes(bytes.TrimSpace(x)) }
+	nonEmpty := func(x []byte) maybe.Bytes {
+		if len(x) == 0 {
+			return maybe.ErrBytes(errors.New("empty"))
+		}
+		return maybe.JustBytes(x)
+	}
+	join := func(x [][]byte) maybe.Bytes { return maybe.JustBytes(bytes.Join(x, []byte(","))) }
+
+	good := maybe.JustBytes([]byte(" a \nb ")).Split(lines)
+	xs, err := good.Map(trim).ToStr().Unbox()
+	is.Equal(xs, []string{"a", "b"})
+	is.Nil(err)
+	is.Equal(good.String(), "Just [[32 97 32] [98 32]]")
+
+	s, err := good.Map(trim).Join(join).ToStr().Unbox()
+	is.Equal(s, "a,b")
+	is.Nil(err)
+
+	_, err = maybe.JustBytes([]byte("a\n\nb")).Split(lines).Map(nonEmpty).Unbox()
+	is.Equal(err.Error(), "element [1]: empty")
+
+	bss, err := maybe.JustAoS([]string{"x", "yz"}).ToBytes().Unbox()
+	is.Equal(bss, [][]byte{[]byte("x"), []byte("yz")})
+	is.Nil(err)
+
+	bad := maybe.ErrAoBytes(errors.New("bad bytes"))
+	is.True(bad.Map(trim).IsErr())
+	is.True(bad.Join(join).IsErr())
+	is.True(bad.ToStr().IsErr())
+	is.True(maybe.ErrBytes(errors.New("bad bytes")).Split(lines).IsErr())
+	is.True(maybe.ErrAoS(errors.New("bad strings")).ToBytes().IsErr())
+	is.True(maybe.AoBytes{}.IsErr())
+	_, err = maybe.AoBytes{}.Unbox()
+	is.NotNil(err)
+}
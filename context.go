@@ -0,0 +1,191 @@
+package maybe
+
+import "context"
+
+// BindCtx is like Bind, but the function also takes a context.  If the
+// context is done, BindCtx returns an invalid Maybe with the context's error
+// instead of calling the function.
+func BindCtx[T, U any](ctx context.Context, m Maybe[T], f func(ctx context.Context, x T) Maybe[U]) Maybe[U] {
+	if m.err != nil {
+		return Err[U](m.err)
+	}
+	if err := ctx.Err(); err != nil {
+		return Err[U](err)
+	}
+
+	return tryCtx(ctx, f, m.just, Err[U])
+}
+
+// BindSliceCtx is like BindSlice, but the function also takes a context.
+// If the context is done, BindSliceCtx returns an invalid Slice with the
+// context's error instead of calling the function.
+func BindSliceCtx[T, U any](ctx context.Context, m Slice[T], f func(ctx context.Context, x []T) Slice[U]) Slice[U] {
+	if m.IsErr() {
+		return ErrSlice[U](zeroErr(m.err, "BindSliceCtx", "Slice"))
+	}
+	if err := ctx.Err(); err != nil {
+		return ErrSlice[U](err)
+	}
+
+	return tryCtx(ctx, f, m.just, ErrSlice[U])
+}
+
+// JoinSliceCtx is like JoinSlice, but the function also takes a context.
+// If the context is done, JoinSliceCtx returns an invalid Maybe with the
+// context's error instead of calling the function.
+func JoinSliceCtx[T, U any](ctx context.Context, m Slice[T], f func(ctx context.Context, x []T) Maybe[U]) Maybe[U] {
+	if m.IsErr() {
+		return Err[U](zeroErr(m.err, "JoinSliceCtx", "Slice"))
+	}
+	if err := ctx.Err(); err != nil {
+		return Err[U](err)
+	}
+
+	return tryCtx(ctx, f, m.just, Err[U])
+}
+
+// SplitSliceCtx is like SplitSlice, but the function also takes a context.
+// The context is checked before each element; once it is done, SplitSliceCtx
+// stops and returns an invalid Grid with the context's error.
+func SplitSliceCtx[T, U any](ctx context.Context, m Slice[T], f func(ctx context.Context, x T) Slice[U]) Grid[U] {
+	if m.IsErr() {
+		return ErrGrid[U](zeroErr(m.err, "SplitSliceCtx", "Slice"))
+	}
+
+	xss := make([][]U, len(m.just))
+	for i, v := range m.just {
+		if err := ctx.Err(); err != nil {
+			return ErrGrid[U](err)
+		}
+		xs, err := tryCtx(ctx, f, v, ErrSlice[U]).Unbox()
+		if err != nil {
+			return ErrGrid[U](elemErr(err, i))
+		}
+		xss[i] = xs
+	}
+
+	return JustGrid(xss)
+}
+
+// MapSliceCtx is like MapSlice, but the function also takes a context.  The
+// context is checked before each element; once it is done, MapSliceCtx
+// stops and returns an invalid Slice with the context's error.
+func MapSliceCtx[T, U any](ctx context.Context, m Slice[T], f func(ctx context.Context, x T) Maybe[U]) Slice[U] {
+	if m.IsErr() {
+		return ErrSlice[U](zeroErr(m.err, "MapSliceCtx", "Slice"))
+	}
+
+	xs := make([]U, len(m.just))
+	for i, v := range m.just {
+		if err := ctx.Err(); err != nil {
+			return ErrSlice[U](err)
+		}
+		x, err := tryCtx(ctx, f, v, Err[U]).Unbox()
+		if err != nil {
+			return ErrSlice[U](elemErr(err, i))
+		}
+		xs[i] = x
+	}
+
+	return JustSlice(xs)
+}
+
+// BindGridCtx is like BindGrid, but the function also takes a context.  If
+// the context is done, BindGridCtx returns an invalid Grid with the context's
+// error instead of calling the function.
+func BindGridCtx[T, U any](ctx context.Context, m Grid[T], f func(ctx context.Context, x [][]T) Grid[U]) Grid[U] {
+	if m.IsErr() {
+		return ErrGrid[U](zeroErr(m.err, "BindGridCtx", "Grid"))
+	}
+	if err := ctx.Err(); err != nil {
+		return ErrGrid[U](err)
+	}
+
+	return tryCtx(ctx, f, m.just, ErrGrid[U])
+}
+
+// JoinGridCtx is like JoinGrid, but the function also takes a context.  The
+// context is checked before each row; once it is done, JoinGridCtx stops and
+// returns an invalid Slice with the context's error.
+func JoinGridCtx[T, U any](ctx context.Context, m Grid[T], f func(ctx context.Context, x []T) Maybe[U]) Slice[U] {
+	if m.IsErr() {
+		return ErrSlice[U](zeroErr(m.err, "JoinGridCtx", "Grid"))
+	}
+
+	xs := make([]U, len(m.just))
+	for i, v := range m.just {
+		if err := ctx.Err(); err != nil {
+			return ErrSlice[U](err)
+		}
+		x, err := tryCtx(ctx, f, v, Err[U]).Unbox()
+		if err != nil {
+			return ErrSlice[U](elemErr(err, i))
+		}
+		xs[i] = x
+	}
+
+	return JustSlice(xs)
+}
+
+// MapGridCtx is like MapGrid, but the function also takes a context.  The
+// context is checked before each row; once it is done, MapGridCtx stops and
+// returns an invalid Grid with the context's error.
+func MapGridCtx[T, U any](ctx context.Context, m Grid[T], f func(ctx context.Context, x []T) Slice[U]) Grid[U] {
+	if m.IsErr() {
+		return ErrGrid[U](zeroErr(m.err, "MapGridCtx", "Grid"))
+	}
+
+	xss := make([][]U, len(m.just))
+	for i, v := range m.just {
+		if err := ctx.Err(); err != nil {
+			return ErrGrid[U](err)
+		}
+		xs, err := tryCtx(ctx, f, v, ErrSlice[U]).Unbox()
+		if err != nil {
+			return ErrGrid[U](elemErr(err, i))
+		}
+		xss[i] = xs
+	}
+
+	return JustGrid(xss)
+}
+
+// MapCellsCtx is like MapCells, but the function also takes a context.  The
+// context is checked before each element; once it is done, MapCellsCtx stops
+// and returns an invalid Grid with the context's error.
+func MapCellsCtx[T, U any](ctx context.Context, m Grid[T], f func(ctx context.Context, x T) Maybe[U]) Grid[U] {
+	if m.IsErr() {
+		return ErrGrid[U](zeroErr(m.err, "MapCellsCtx", "Grid"))
+	}
+
+	xss := make([][]U, len(m.just))
+	for i, xs := range m.just {
+		xss[i] = make([]U, len(xs))
+		for j, v := range xs {
+			if err := ctx.Err(); err != nil {
+				return ErrGrid[U](err)
+			}
+			x, err := tryCtx(ctx, f, v, Err[U]).Unbox()
+			if err != nil {
+				return ErrGrid[U](elemErr(err, i, j))
+			}
+			xss[i][j] = x
+		}
+	}
+
+	return JustGrid(xss)
+}
+
+func toMaybeCtx[T, U any, M ~struct {
+	just U
+	err  error
+}](f func(ctx context.Context, x T) M) func(ctx context.Context, x T) Maybe[U] {
+	return func(ctx context.Context, x T) Maybe[U] { return Maybe[U](f(ctx, x)) }
+}
+
+func toSliceCtx[T, U any, M ~struct {
+	just []U
+	err  error
+}](f func(ctx context.Context, x T) M) func(ctx context.Context, x T) Slice[U] {
+	return func(ctx context.Context, x T) Slice[U] { return Slice[U](f(ctx, x)) }
+}
@@ -0,0 +1,100 @@
+package maybe_test
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/xdg/maybe"
+	"github.com/xdg/testy"
+)
+
+func TestBindCtx(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	ctx := context.Background()
+	done, cancel := context.WithCancel(ctx)
+	cancel()
+
+	neg := func(ctx context.Context, x int) maybe.I { return maybe.JustI(-x) }
+
+	got, err := maybe.JustI(42).BindCtx(ctx, neg).Unbox()
+	is.Equal(got, -42)
+	is.Nil(err)
+
+	_, err = maybe.JustI(42).BindCtx(done, neg).Unbox()
+	is.Equal(err, context.Canceled)
+
+	_, err = maybe.ErrI(errors.New("bad int")).BindCtx(done, neg).Unbox()
+	is.Equal(err.Error(), "bad int")
+
+	atoi := func(ctx context.Context, s string) maybe.Maybe[int] { return maybe.New(strconv.Atoi(s)) }
+	n, err := maybe.BindCtx(ctx, maybe.Just("23"), atoi).Unbox()
+	is.Equal(n, 23)
+	is.Nil(err)
+	_, err = maybe.BindCtx(done, maybe.Just("23"), atoi).Unbox()
+	is.Equal(err, context.Canceled)
+}
+
+func TestMapCtx(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	// Cancel the context partway through
+	ctx, cancel := context.WithCancel(context.Background())
+	var seen []string
+	atoi := func(ctx context.Context, s string) maybe.I {
+		seen = append(seen, s)
+		if s == "3" {
+			cancel()
+		}
+		return maybe.NewI(strconv.Atoi(s))
+	}
+
+	got, err := maybe.JustAoS([]string{"1", "2"}).ToIntCtx(context.Background(), atoi).Unbox()
+	is.Equal(got, []int{1, 2})
+	is.Nil(err)
+
+	seen = nil
+	_, err = maybe.JustAoS([]string{"1", "3", "5"}).ToIntCtx(ctx, atoi).Unbox()
+	is.Equal(err, context.Canceled)
+	is.Equal(seen, []string{"1", "3"})
+
+	// Callback errors are still tagged with their position
+	var ee *maybe.ElementError
+	_, err = maybe.JustAoS([]string{"1", "x"}).ToIntCtx(context.Background(), atoi).Unbox()
+	is.True(errors.As(err, &ee))
+	is.Equal(ee.Index, []int{1})
+}
+
+func TestGridCtx(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	done, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	input := maybe.JustAoAoS([][]string{{"1", "2"}, {"3"}})
+	atoi := func(ctx context.Context, s string) maybe.I { return maybe.NewI(strconv.Atoi(s)) }
+
+	got, err := input.ToIntCtx(context.Background(), atoi).Unbox()
+	is.Equal(got, [][]int{{1, 2}, {3}})
+	is.Nil(err)
+
+	_, err = input.ToIntCtx(done, atoi).Unbox()
+	is.Equal(err, context.Canceled)
+
+	count := func(ctx context.Context, xs []string) maybe.S { return maybe.JustS(strconv.Itoa(len(xs))) }
+	counts, err := input.JoinCtx(context.Background(), count).Unbox()
+	is.Equal(counts, []string{"2", "1"})
+	is.Nil(err)
+	is.True(input.JoinCtx(done, count).IsErr())
+
+	split := func(ctx context.Context, s string) maybe.AoS { return maybe.JustAoS([]string{s, s}) }
+	is.True(maybe.JustAoS([]string{"a"}).SplitCtx(done, split).IsErr())
+	pairs, err := maybe.JustAoS([]string{"a"}).SplitCtx(context.Background(), split).Unbox()
+	is.Equal(pairs, [][]string{{"a", "a"}})
+	is.Nil(err)
+}
@@ -0,0 +1,75 @@
+package maybe
+
+import (
+	"encoding/csv"
+	"io"
+)
+
+// CSVOptions controls how AoAoSFromCSV and WriteCSV handle CSV data.  The
+// fields correspond to those of csv.Reader and csv.Writer; the zero value
+// gives their defaults.  For TSV, set Comma to '\t'.
+type CSVOptions struct {
+	// Comma is the field delimiter.  If zero, ',' is used.
+	Comma rune
+
+	// Comment, if not zero, is a character that starts a comment line when
+	// reading.
+	Comment rune
+
+	// LazyQuotes allows quotes in unquoted fields and non-doubled quotes
+	// in quoted fields when reading.
+	LazyQuotes bool
+
+	// FieldsPerRecord is the number of fields each record must have when
+	// reading.  If zero, it is set by the first record; if negative, records
+	// may have any number of fields.
+	FieldsPerRecord int
+
+	// TrimLeadingSpace ignores leading white space in fields when reading.
+	TrimLeadingSpace bool
+
+	// UseCRLF ends records with "\r\n" instead of "\n" when writing.
+	UseCRLF bool
+}
+
+// AoAoSFromCSV constructs an AoAoS from CSV records read from r, parsed
+// according to opts.  If reading or parsing fails, returns an invalid AoAoS;
+// parse errors are *csv.ParseError values, which record the line and column.
+func AoAoSFromCSV(r io.Reader, opts CSVOptions) AoAoS {
+	cr := csv.NewReader(r)
+	if opts.Comma != 0 {
+		cr.Comma = opts.Comma
+	}
+	cr.Comment = opts.Comment
+	cr.LazyQuotes = opts.LazyQuotes
+	cr.FieldsPerRecord = opts.FieldsPerRecord
+	cr.TrimLeadingSpace = opts.TrimLeadingSpace
+
+	records, err := cr.ReadAll()
+	if err != nil {
+		return ErrAoAoS(err)
+	}
+	if records == nil {
+		records = [][]string{}
+	}
+
+	return JustAoAoS(records)
+}
+
+// WriteCSV writes a valid AoAoS to w as CSV records, formatted according to
+// opts.  If the AoAoS is invalid, nothing is written and its error is
+// returned.
+func (m AoAoS) WriteCSV(w io.Writer, opts CSVOptions) error {
+	xss, err := m.Unbox()
+	if err != nil {
+		return err
+	}
+
+	cw := csv.NewWriter(w)
+	if opts.Comma != 0 {
+		cw.Comma = opts.Comma
+	}
+	cw.UseCRLF = opts.UseCRLF
+
+	return cw.WriteAll(xss)
+}
@@ -0,0 +1,101 @@
+package maybe_test
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/xdg/maybe"
+	"github.com/xdg/testy"
+)
+
+func TestAoAoSFromCSV(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	input := "name,count\n# comment\n\"Smith, J\",23\n"
+	got, err := maybe.AoAoSFromCSV(strings.NewReader(input), maybe.CSVOptions{Comment: '#'}).Unbox()
+	is.Equal(got, [][]string{{"name", "count"}, {"Smith, J", "23"}})
+	is.Nil(err)
+
+	// TSV
+	got, err = maybe.AoAoSFromCSV(strings.NewReader("a\tb\nc\td\n"), maybe.CSVOptions{Comma: '\t'}).Unbox()
+	is.Equal(got, [][]string{{"a", "b"}, {"c", "d"}})
+	is.Nil(err)
+
+	// Empty input is valid
+	got, err = maybe.AoAoSFromCSV(strings.NewReader(""), maybe.CSVOptions{}).Unbox()
+	is.Equal(got, [][]string{})
+	is.Nil(err)
+
+	// Lazy quotes
+	_, err = maybe.AoAoSFromCSV(strings.NewReader("a \"b\" c\n"), maybe.CSVOptions{}).Unbox()
+	is.NotNil(err)
+	got, err = maybe.AoAoSFromCSV(strings.NewReader("a \"b\" c\n"), maybe.CSVOptions{LazyQuotes: true}).Unbox()
+	is.Equal(got, [][]string{{"a \"b\" c"}})
+	is.Nil(err)
+
+	// Variable fields per record
+	got, err = maybe.AoAoSFromCSV(strings.NewReader("a,b\nc\n"), maybe.CSVOptions{FieldsPerRecord: -1}).Unbox()
+	is.Equal(got, [][]string{{"a", "b"}, {"c"}})
+	is.Nil(err)
+}
+
+func TestAoAoSFromCSVErrors(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	var pe *csv.ParseError
+
+	// Fields per record enforced
+	m := maybe.AoAoSFromCSV(strings.NewReader("a,b\nc\n"), maybe.CSVOptions{})
+	_, err := m.Unbox()
+	is.True(errors.As(err, &pe))
+	is.Equal(pe.Line, 2)
+	is.True(errors.Is(err, csv.ErrFieldCount))
+
+	m = maybe.AoAoSFromCSV(strings.NewReader("a,b\nc,d,e\n"), maybe.CSVOptions{FieldsPerRecord: 2})
+	_, err = m.Unbox()
+	is.True(errors.Is(err, csv.ErrFieldCount))
+
+	// Parse errors carry the line and column
+	m = maybe.AoAoSFromCSV(strings.NewReader("a,b\nc,\"d\"x\n"), maybe.CSVOptions{})
+	_, err = m.Unbox()
+	is.True(errors.As(err, &pe))
+	is.Equal(pe.Line, 2)
+	is.Equal(pe.Column, 5)
+
+	// The error flows through the rest of a chain
+	atoi := func(s string) maybe.I { return maybe.NewI(strconv.Atoi(s)) }
+	_, err = m.ToInt(atoi).Unbox()
+	is.True(errors.As(err, &pe))
+}
+
+func TestAoAoSWriteCSV(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	input := [][]string{{"name", "count"}, {"Smith, J", "23"}}
+	var buf bytes.Buffer
+
+	is.Nil(maybe.JustAoAoS(input).WriteCSV(&buf, maybe.CSVOptions{}))
+	is.Equal(buf.String(), "name,count\n\"Smith, J\",23\n")
+
+	// Round trip
+	got, err := maybe.AoAoSFromCSV(&buf, maybe.CSVOptions{}).Unbox()
+	is.Equal(got, input)
+	is.Nil(err)
+
+	buf.Reset()
+	is.Nil(maybe.JustAoAoS(input).WriteCSV(&buf, maybe.CSVOptions{Comma: '\t', UseCRLF: true}))
+	is.Equal(buf.String(), "name\tcount\r\nSmith, J\t23\r\n")
+
+	// Invalid input writes nothing
+	buf.Reset()
+	err = maybe.ErrAoAoS(errors.New("bad strings")).WriteCSV(&buf, maybe.CSVOptions{})
+	is.Equal(err.Error(), "bad strings")
+	is.Equal(buf.Len(), 0)
+}
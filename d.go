@@ -0,0 +1,134 @@
+package maybe
+
+import (
+	"fmt"
+	"time"
+)
+
+// D implements the Maybe monad for a time.Duration.  A D is considered
+// 'valid' or 'invalid' depending on whether it contains a time.Duration or an
+// error value.
+type D Maybe[time.Duration]
+
+// NewD constructs a D from a given time.Duration or error. If e is not nil,
+// returns ErrD(e), otherwise returns JustD(x).
+func NewD(x time.Duration, e error) D {
+	if e != nil {
+		return ErrD(e)
+	}
+	return JustD(x)
+}
+
+// JustD constructs a valid D from a given time.Duration.
+func JustD(x time.Duration) D {
+	return D{just: x}
+}
+
+// ErrD constructs an invalid D from a given error.
+func ErrD(e error) D {
+	return D{err: e}
+}
+
+// NothingD constructs an invalid D that holds nothing, for a missing rather
+// than a failed value.  See ErrNothing.
+func NothingD() D {
+	return D{err: ErrNothing}
+}
+
+// IsErr returns true for an invalid D.
+func (m D) IsErr() bool {
+	return m.err != nil
+}
+
+// Bind applies a function that takes a time.Duration and returns a D.
+func (m D) Bind(f func(x time.Duration) D) D {
+	if m.err != nil {
+		return m
+	}
+
+	return try(f, m.just, ErrD)
+}
+
+// Split applies a function that takes a time.Duration and returns an AoD.
+func (m D) Split(f func(x time.Duration) AoD) AoD {
+	if m.err != nil {
+		return ErrAoD(m.err)
+	}
+
+	return try(f, m.just, ErrAoD)
+}
+
+// Format formats a valid D as time.Duration.String does, e.g. "1h30m0s",
+// resulting in an S.  If the D is invalid, Format returns an invalid S.
+func (m D) Format() S {
+	if m.err != nil {
+		return ErrS(m.err)
+	}
+
+	return JustS(m.just.String())
+}
+
+// IsNothing returns true for a D that holds nothing, i.e. one whose error is
+// ErrNothing itself.  Such a D is also invalid, so IsErr returns true.
+func (m D) IsNothing() bool {
+	return Maybe[time.Duration](m).IsNothing()
+}
+
+// JustOr returns a valid D holding a default value if the D holds nothing, or
+// otherwise returns the D unchanged.  Unlike OrElse, it leaves other errors in
+// place.
+func (m D) JustOr(x time.Duration) D {
+	return D(Maybe[time.Duration](m).JustOr(x))
+}
+
+// OrElse returns the D if it is valid, or otherwise a valid D holding a default
+// value.
+func (m D) OrElse(x time.Duration) D {
+	return D(Maybe[time.Duration](m).OrElse(x))
+}
+
+// Recover returns the D if it is valid, or otherwise a valid D holding the
+// value returned by a function that takes the error.
+func (m D) Recover(f func(err error) time.Duration) D {
+	return D(Maybe[time.Duration](m).Recover(f))
+}
+
+// MapErr returns the D if it is valid, or otherwise an invalid D with the
+// error returned by a function that takes the original error, e.g. to add
+// context with fmt.Errorf and %w.  If the function returns nil, the original
+// error is kept.
+func (m D) MapErr(f func(err error) error) D {
+	return D(Maybe[time.Duration](m).MapErr(f))
+}
+
+// Catch is like Recover, but only for errors that match a target according
+// to errors.Is, and the function returns a D, so it may fail again.  Valid
+// values and other errors are returned unchanged.
+func (m D) Catch(target error, f func(err error) D) D {
+	return D(Maybe[time.Duration](m).Catch(target, toMaybe(f)))
+}
+
+// MarshalJSON implements json.Marshaler using the wire format described in
+// the package documentation.
+func (m D) MarshalJSON() ([]byte, error) {
+	return marshalJSON(m.Unbox())
+}
+
+// UnmarshalJSON implements json.Unmarshaler using the wire format described
+// in the package documentation.
+func (m *D) UnmarshalJSON(b []byte) error {
+	return unmarshalJSON(b, (*Maybe[time.Duration])(m), "D")
+}
+
+// String returns a string representation, mostly useful for debugging.
+func (m D) String() string {
+	if m.err != nil {
+		return fmt.Sprintf("Err %v", m.err)
+	}
+	return fmt.Sprintf("Just %v", m.just)
+}
+
+// Unbox returns the underlying time.Duration value or error.
+func (m D) Unbox() (time.Duration, error) {
+	return m.just, m.err
+}
@@ -0,0 +1,63 @@
+package maybe_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/xdg/maybe"
+	"github.com/xdg/testy"
+)
+
+func TestDuration(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	good := maybe.JustD(90 * time.Second)
+	bad := maybe.ErrD(errors.New("bad duration"))
+
+	just, err := good.Unbox()
+	is.Equal(just, 90*time.Second)
+	is.Nil(err)
+	is.False(good.IsErr())
+
+	just, err = bad.Unbox()
+	is.Equal(just, time.Duration(0))
+	is.Equal(err.Error(), "bad duration")
+	is.True(bad.IsErr())
+
+	is.Equal(maybe.NewD(90*time.Second, nil), good)
+	is.True(maybe.NewD(0, err).IsErr())
+
+	is.Equal(good.String(), "Just 1m30s")
+	is.Equal(bad.String(), "Err bad duration")
+
+	double := func(x time.Duration) maybe.D { return maybe.JustD(2 * x) }
+	just, err = good.Bind(double).Unbox()
+	is.Equal(just, 3*time.Minute)
+	is.Nil(err)
+	is.True(bad.Bind(double).IsErr())
+
+	both := func(x time.Duration) maybe.AoD { return maybe.JustAoD([]time.Duration{x, -x}) }
+	xs, err := good.Split(both).Unbox()
+	is.Equal(xs, []time.Duration{90 * time.Second, -90 * time.Second})
+	is.Nil(err)
+	is.True(bad.Split(both).IsErr())
+}
+
+func TestDurationParseFormat(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	just, err := maybe.JustS("1h30m").ToDuration().Unbox()
+	is.Equal(just, 90*time.Minute)
+	is.Nil(err)
+
+	is.True(maybe.JustS("90 minutes").ToDuration().IsErr())
+	is.True(maybe.ErrS(errors.New("bad string")).ToDuration().IsErr())
+
+	s, err := maybe.JustS("90m").ToDuration().Format().Unbox()
+	is.Equal(s, "1h30m0s")
+	is.Nil(err)
+	is.True(maybe.ErrD(errors.New("bad duration")).Format().IsErr())
+}
@@ -0,0 +1,301 @@
+package maybe
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// Dict implements the Maybe monad for a map from strings to an arbitrary type
+// V.  A Dict is considered 'valid' or 'invalid' depending on whether it
+// contains a map or an error value.  A zero-value Dict is invalid and Unbox()
+// will return an error to that effect.
+//
+// MoS, MoI and MoX are defined on top of Dict and convert to and from it
+// without copying, e.g. `maybe.Dict[int](m)` and `maybe.MoI(d)`.
+type Dict[V any] struct {
+	just map[string]V
+	err  error
+}
+
+// NewDict constructs a Dict from a given map or error.  If e is not nil,
+// returns ErrDict(e), otherwise returns JustDict(x).
+func NewDict[V any](x map[string]V, e error) Dict[V] {
+	if e != nil {
+		return ErrDict[V](e)
+	}
+	return JustDict(x)
+}
+
+// JustDict constructs a valid Dict from a given map.
+func JustDict[V any](x map[string]V) Dict[V] {
+	return Dict[V]{just: x}
+}
+
+// ErrDict constructs an invalid Dict from a given error.
+func ErrDict[V any](e error) Dict[V] {
+	return Dict[V]{err: e}
+}
+
+// NothingDict constructs an invalid Dict that holds nothing, for a missing
+// rather than a failed value.  See ErrNothing.
+func NothingDict[V any]() Dict[V] {
+	return Dict[V]{err: ErrNothing}
+}
+
+// IsErr returns true for an invalid Dict.
+func (m Dict[V]) IsErr() bool {
+	return m.just == nil || m.err != nil
+}
+
+// Bind applies a function that takes a map and returns a Dict.
+func (m Dict[V]) Bind(f func(x map[string]V) Dict[V]) Dict[V] {
+	if m.IsErr() {
+		return m
+	}
+
+	return try(f, m.just, ErrDict[V])
+}
+
+// MapValues applies a function to each value of a valid Dict and returns a
+// new Dict with the same keys.  See MapDictValues for details.
+func (m Dict[V]) MapValues(f func(x V) Maybe[V]) Dict[V] {
+	return MapDictValues(m, f)
+}
+
+// Filter returns a new Dict with only the entries of a valid Dict for which
+// a function returns true.  Entries are visited in key order.  If the Dict is
+// invalid, Filter returns an invalid Dict.  If the function panics and panic
+// recovery is enabled, the error is wrapped in a KeyError with the key.
+func (m Dict[V]) Filter(f func(k string, v V) bool) Dict[V] {
+	if m.IsErr() {
+		return m
+	}
+
+	x := make(map[string]V)
+	for _, k := range sortedKeys(m.just) {
+		v := m.just[k]
+		ok, err := try(func(v V) Maybe[bool] { return Just(f(k, v)) }, v, Err[bool]).Unbox()
+		if err != nil {
+			return ErrDict[V](&KeyError{Key: k, Err: err})
+		}
+		if ok {
+			x[k] = v
+		}
+	}
+
+	return JustDict(x)
+}
+
+// Keys returns the keys of a valid Dict in sorted order.  If the Dict is
+// invalid, Keys returns an invalid Slice.
+func (m Dict[V]) Keys() Slice[string] {
+	if m.IsErr() {
+		return ErrSlice[string](zeroErr(m.err, "Keys", "Dict"))
+	}
+
+	return JustSlice(sortedKeys(m.just))
+}
+
+// Get looks up a key in a valid Dict, resulting in a valid Maybe holding its
+// value, or Nothing if the key is absent.  If the Dict is invalid, Get
+// returns an invalid Maybe with its error.
+func (m Dict[V]) Get(k string) Maybe[V] {
+	if m.IsErr() {
+		return Err[V](zeroErr(m.err, "Get", "Dict"))
+	}
+
+	v, ok := m.just[k]
+	return FromOK(v, ok)
+}
+
+// IsNothing returns true for a Dict that holds nothing, i.e. one whose error is
+// ErrNothing itself.  Such a Dict is also invalid, so IsErr returns true.  An
+// error that only wraps ErrNothing, such as an ElementError for a missing
+// element, is an ordinary error.
+func (m Dict[V]) IsNothing() bool {
+	return m.err == ErrNothing
+}
+
+// JustOr returns a valid Dict holding a default value if the Dict holds
+// nothing, or otherwise returns the Dict unchanged.  Unlike OrElse, it leaves
+// other errors in place.  A nil default is replaced by an empty one, as for
+// OrElse.
+func (m Dict[V]) JustOr(x map[string]V) Dict[V] {
+	if m.IsNothing() {
+		return JustDict(orEmptyMap(x))
+	}
+	return m
+}
+
+// OrElse returns the Dict if it is valid, or otherwise a valid Dict holding a
+// default value.  A nil default is replaced by an empty one, so the result is
+// always valid.
+func (m Dict[V]) OrElse(x map[string]V) Dict[V] {
+	if m.IsErr() {
+		return JustDict(orEmptyMap(x))
+	}
+	return m
+}
+
+// Recover returns the Dict if it is valid, or otherwise a valid Dict holding
+// the value returned by a function that takes the error, with nil replaced by
+// an empty value as for OrElse.
+func (m Dict[V]) Recover(f func(err error) map[string]V) Dict[V] {
+	if !m.IsErr() {
+		return m
+	}
+	_, err := m.Unbox()
+	return try(func(err error) Dict[V] { return JustDict(orEmptyMap(f(err))) }, err, ErrDict[V])
+}
+
+// MapErr returns the Dict if it is valid, or otherwise an invalid Dict with the
+// error returned by a function that takes the original error, e.g. to add
+// context with fmt.Errorf and %w.  If the function returns nil, the original
+// error is kept.
+func (m Dict[V]) MapErr(f func(err error) error) Dict[V] {
+	if !m.IsErr() {
+		return m
+	}
+	_, err := m.Unbox()
+	if e := try(f, err, func(e error) error { return e }); e != nil {
+		return ErrDict[V](e)
+	}
+	return ErrDict[V](err)
+}
+
+// Catch is like Recover, but only for errors that match a target according
+// to errors.Is, and the function returns a Dict, so it may fail again.  Valid
+// values and other errors are returned unchanged.
+func (m Dict[V]) Catch(target error, f func(err error) Dict[V]) Dict[V] {
+	if !m.IsErr() {
+		return m
+	}
+	_, err := m.Unbox()
+	if !errors.Is(err, target) {
+		return m
+	}
+	return try(f, err, ErrDict[V])
+}
+
+// MarshalJSON implements json.Marshaler using the wire format described in
+// the package documentation.
+func (m Dict[V]) MarshalJSON() ([]byte, error) {
+	return marshalJSON(m.Unbox())
+}
+
+// UnmarshalJSON implements json.Unmarshaler using the wire format described
+// in the package documentation.
+func (m *Dict[V]) UnmarshalJSON(b []byte) error {
+	return unmarshalJSON(b, (*Maybe[map[string]V])(m), "Dict")
+}
+
+// String returns a string representation, mostly useful for debugging.
+func (m Dict[V]) String() string {
+	if m.IsErr() {
+		return fmt.Sprintf("Err %v", m.err)
+	}
+	return fmt.Sprintf("Just %v", m.just)
+}
+
+// checkZero turns a zero-value Dict into an invalid one whose error names
+// the given operation and type, so that a named type delegating to a generic
+// function reports its own method rather than the generic one.
+func (m Dict[V]) checkZero(op, typ string) Dict[V] {
+	if m.just == nil && m.err == nil {
+		return ErrDict[V](&OpError{Op: op, Type: typ, Err: ErrZeroValue})
+	}
+	return m
+}
+
+// Unbox returns the underlying map or error.
+func (m Dict[V]) Unbox() (map[string]V, error) {
+	if m.just == nil && m.err == nil {
+		return nil, &OpError{Op: "Unbox", Type: "Dict", Err: ErrZeroValue}
+	}
+	return m.just, m.err
+}
+
+// BindDict applies a function that takes a map with values of type V and
+// returns a Dict[U].
+func BindDict[V, U any](m Dict[V], f func(x map[string]V) Dict[U]) Dict[U] {
+	if m.IsErr() {
+		return ErrDict[U](zeroErr(m.err, "BindDict", "Dict"))
+	}
+
+	return try(f, m.just, ErrDict[U])
+}
+
+// MapDictValues applies a function to each value of a valid Dict[V] and
+// returns a Dict[U] with the same keys.  Values are visited in key order.  If
+// the Dict is invalid or if any function returns an invalid Maybe,
+// MapDictValues returns an invalid Dict, with the error wrapped in a KeyError
+// with the first failing key.
+func MapDictValues[V, U any](m Dict[V], f func(x V) Maybe[U]) Dict[U] {
+	if m.IsErr() {
+		return ErrDict[U](zeroErr(m.err, "MapDictValues", "Dict"))
+	}
+
+	x := make(map[string]U, len(m.just))
+	for _, k := range sortedKeys(m.just) {
+		v, err := try(f, m.just[k], Err[U]).Unbox()
+		if err != nil {
+			return ErrDict[U](&KeyError{Key: k, Err: err})
+		}
+		x[k] = v
+	}
+
+	return JustDict(x)
+}
+
+// GridToDict builds a Dict[V] from the rows of a valid Grid[T], each of which
+// must hold exactly two elements: a key and a value.  The key function
+// converts the first element to a string and the value function converts the
+// second element to a V.  If unique is true, a repeated key is an error
+// wrapping ErrDuplicateKey; otherwise, later rows overwrite earlier ones.
+// Failures are wrapped in an ElementError with the row index, plus the column
+// index for a failing key or value function.
+func GridToDict[T, V any](m Grid[T], key func(x T) Maybe[string], value func(x T) Maybe[V], unique bool) Dict[V] {
+	if m.IsErr() {
+		return ErrDict[V](zeroErr(m.err, "GridToDict", "Grid"))
+	}
+
+	x := make(map[string]V, len(m.just))
+	for i, row := range m.just {
+		if len(row) != 2 {
+			return ErrDict[V](elemErr(fmt.Errorf("row has %d fields, want 2", len(row)), i))
+		}
+		k, err := try(key, row[0], Err[string]).Unbox()
+		if err != nil {
+			return ErrDict[V](elemErr(err, i, 0))
+		}
+		v, err := try(value, row[1], Err[V]).Unbox()
+		if err != nil {
+			return ErrDict[V](elemErr(err, i, 1))
+		}
+		if _, ok := x[k]; ok && unique {
+			return ErrDict[V](elemErr(fmt.Errorf("%w %q", ErrDuplicateKey, k), i))
+		}
+		x[k] = v
+	}
+
+	return JustDict(x)
+}
+
+func sortedKeys[V any](x map[string]V) []string {
+	keys := make([]string, 0, len(x))
+	for k := range x {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// orEmptyMap replaces a nil map with an empty one, as a nil map makes a Dict
+// invalid.
+func orEmptyMap[V any](x map[string]V) map[string]V {
+	if x == nil {
+		return map[string]V{}
+	}
+	return x
+}
@@ -0,0 +1,128 @@
+package maybe_test
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/xdg/maybe"
+	"github.com/xdg/testy"
+)
+
+func TestDict(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	input := map[string]int{"b": 2, "a": 1}
+	good := maybe.JustDict(input)
+	bad := maybe.ErrDict[int](errors.New("bad dict"))
+
+	just, err := good.Unbox()
+	is.Equal(just, input)
+	is.Nil(err)
+	is.False(good.IsErr())
+
+	just, err = bad.Unbox()
+	is.Nil(just)
+	is.Equal(err.Error(), "bad dict")
+	is.True(bad.IsErr())
+
+	is.Equal(maybe.NewDict(input, nil), good)
+	is.True(maybe.NewDict[int](nil, err).IsErr())
+
+	is.Equal(good.String(), "Just map[a:1 b:2]")
+	is.Equal(bad.String(), "Err bad dict")
+
+	zero := maybe.Dict[int]{}
+	is.True(zero.IsErr())
+	_, err = zero.Unbox()
+	is.NotNil(err)
+
+	keys, err := good.Keys().Unbox()
+	is.Equal(keys, []string{"a", "b"})
+	is.Nil(err)
+	is.True(bad.Keys().IsErr())
+
+	odd, err := good.Filter(func(k string, v int) bool { return v%2 == 1 }).Unbox()
+	is.Equal(odd, map[string]int{"a": 1})
+	is.Nil(err)
+	is.True(bad.Filter(func(k string, v int) bool { return true }).IsErr())
+
+	double := func(x int) maybe.Maybe[int] { return maybe.Just(2 * x) }
+	doubled, err := good.MapValues(double).Unbox()
+	is.Equal(doubled, map[string]int{"a": 2, "b": 4})
+	is.Nil(err)
+	is.Equal(input, map[string]int{"a": 1, "b": 2})
+
+	empty := func(x map[string]int) maybe.Dict[int] { return maybe.JustDict(map[string]int{}) }
+	just, err = good.Bind(empty).Unbox()
+	is.Equal(just, map[string]int{})
+	is.Nil(err)
+	is.True(bad.Bind(empty).IsErr())
+}
+
+func TestDictConversions(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	atoi := func(s string) maybe.Maybe[int] { return maybe.New(strconv.Atoi(s)) }
+	strs := maybe.JustDict(map[string]string{"x": "1", "y": "two", "z": "three"})
+
+	_, err := maybe.MapDictValues(strs, atoi).Unbox()
+	is.Equal(err.Error(), `key "y": strconv.Atoi: parsing "two": invalid syntax`)
+
+	ints, err := maybe.MapDictValues(strs.Filter(func(k, v string) bool { return k == "x" }), atoi).Unbox()
+	is.Equal(ints, map[string]int{"x": 1})
+	is.Nil(err)
+
+	lens := maybe.BindDict(strs, func(x map[string]string) maybe.Dict[int] {
+		return maybe.JustDict(map[string]int{"n": len(x)})
+	})
+	n, err := lens.Unbox()
+	is.Equal(n, map[string]int{"n": 3})
+	is.Nil(err)
+	is.True(maybe.BindDict(maybe.ErrDict[string](errors.New("bad")), func(x map[string]string) maybe.Dict[int] {
+		return maybe.JustDict(map[string]int{})
+	}).IsErr())
+
+	grid := maybe.JustGrid([][]string{{"a", "1"}, {"b", "2"}, {"a", "3"}})
+	d, err := maybe.GridToDict(grid, maybe.Just[string], atoi, false).Unbox()
+	is.Equal(d, map[string]int{"a": 3, "b": 2})
+	is.Nil(err)
+
+	_, err = maybe.GridToDict(grid, maybe.Just[string], atoi, true).Unbox()
+	is.True(errors.Is(err, maybe.ErrDuplicateKey))
+	var ee *maybe.ElementError
+	is.True(errors.As(err, &ee))
+	is.Equal(ee.Index, []int{2})
+	is.Equal(err.Error(), `element [2]: duplicate key "a"`)
+
+	_, err = maybe.GridToDict(maybe.JustGrid([][]string{{"a", "x"}}), maybe.Just[string], atoi, false).Unbox()
+	is.True(errors.As(err, &ee))
+	is.Equal(ee.Index, []int{0, 1})
+
+	_, err = maybe.GridToDict(maybe.JustGrid([][]string{{"a", "1", "2"}}), maybe.Just[string], atoi, false).Unbox()
+	is.Equal(err.Error(), "element [0]: row has 3 fields, want 2")
+
+	is.True(maybe.GridToDict(maybe.ErrGrid[string](errors.New("bad")), maybe.Just[string], atoi, false).IsErr())
+}
+
+func TestDictGet(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	d := maybe.JustDict(map[string]int{"a": 1, "zero": 0})
+	is.Equal(d.Get("a"), maybe.Just(1))
+	is.Equal(d.Get("zero"), maybe.Just(0))
+	is.True(d.Get("b").IsNothing())
+	is.Equal(d.Get("b").JustOr(-1), maybe.Just(-1))
+
+	_, err := maybe.Dict[int]{}.Get("a").Unbox()
+	is.Equal(err.Error(), "Dict.Get: zero value")
+	is.False(maybe.ErrDict[int](errors.New("bad")).Get("a").IsNothing())
+
+	n := maybe.NothingDict[int]()
+	is.True(n.IsNothing())
+	is.True(n.Get("a").IsNothing())
+	is.Equal(n.JustOr(map[string]int{}), maybe.JustDict(map[string]int{}))
+}
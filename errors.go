@@ -0,0 +1,128 @@
+package maybe
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Sentinel errors for failures originating in this package rather than in
+// callbacks.  They are wrapped in an OpError, or in an ElementError when the
+// failure is tied to one element, so test for them with errors.Is.
+var (
+	// ErrZeroValue means a container was used without being constructed,
+	// e.g. a zero-value AoI, so it has neither a value nor an error.
+	ErrZeroValue = errors.New("zero value")
+
+	// ErrNotSlice means a constructor that takes an arbitrary value, such as
+	// NewAoXFromSlice, was given something other than a slice.
+	ErrNotSlice = errors.New("not a slice")
+
+	// ErrNilValue means a value that must not be nil was nil, e.g. an X
+	// constructed from a nil interface.
+	ErrNilValue = errors.New("nil value")
+
+	// ErrRagged means an operation that works on the columns of a 2-D
+	// container, such as FoldCols, was given rows of different lengths.
+	ErrRagged = errors.New("ragged rows")
+
+	// ErrLengthMismatch means an operation that pairs up the elements of two
+	// containers, such as Zip, was given containers of different lengths.
+	ErrLengthMismatch = errors.New("length mismatch")
+
+	// ErrWireFormat means UnmarshalJSON was given JSON that isn't an object
+	// with a "just", "err" or "nothing" key.
+	ErrWireFormat = errors.New("not in wire format")
+
+	// ErrNoElements means an aggregate with no meaningful result for an
+	// empty container, such as AoT.Earliest, was given an empty one.
+	ErrNoElements = errors.New("no elements")
+
+	// ErrDuplicateKey means a conversion to a map container that requires
+	// unique keys, such as AoAoS.ToMoS, found a key more than once.
+	ErrDuplicateKey = errors.New("duplicate key")
+)
+
+// ErrNothing is the error held by a container that holds nothing, such as
+// one built with NothingI or FromPtr(nil), to tell a missing value apart from
+// a failed one.  Unlike the sentinels above, it is not wrapped in an OpError.
+var ErrNothing = errors.New("nothing")
+
+// OpError records a failure originating in this package, with the operation
+// (e.g. "Unbox" or "Join") and the container type it was called on.  Err is
+// one of the sentinel errors above.
+type OpError struct {
+	Op   string
+	Type string
+	Err  error
+}
+
+// Error returns the type, operation and underlying error message, e.g.
+// "AoI.Join: zero value".
+func (e *OpError) Error() string {
+	return fmt.Sprintf("%s.%s: %v", e.Type, e.Op, e.Err)
+}
+
+// Unwrap returns the underlying sentinel error.
+func (e *OpError) Unwrap() error {
+	return e.Err
+}
+
+// ElementError records the position of the container element whose callback
+// failed during Map, Split, Join, ToInt, ToStr and similar operations.  Index
+// holds a single index for 1-D containers and for row-wise operations on 2-D
+// containers, or a row and a column for element-wise operations on 2-D
+// containers.
+type ElementError struct {
+	Index []int
+	Err   error
+}
+
+// Error returns the position and the underlying error message.
+func (e *ElementError) Error() string {
+	return fmt.Sprintf("element %v: %v", e.Index, e.Err)
+}
+
+// Unwrap returns the error returned by the callback.
+func (e *ElementError) Unwrap() error {
+	return e.Err
+}
+
+// KeyError is the ElementError of the map containers: it records the key of
+// the element whose callback failed during Filter, Map and similar
+// operations on a Dict or one of the Mo_ types.
+type KeyError struct {
+	Key string
+	Err error
+}
+
+// Error returns the key and the underlying error message.
+func (e *KeyError) Error() string {
+	return fmt.Sprintf("key %q: %v", e.Key, e.Err)
+}
+
+// Unwrap returns the error returned by the callback.
+func (e *KeyError) Unwrap() error {
+	return e.Err
+}
+
+func elemErr(err error, idx ...int) error {
+	return &ElementError{Index: idx, Err: err}
+}
+
+// zeroErr returns err for an invalid container, or, if err is nil because
+// the container is a zero value, an OpError saying so.  Without it, the nil
+// error of a zero value would turn into a valid scalar result.
+func zeroErr(err error, op, typ string) error {
+	if err != nil {
+		return err
+	}
+	return &OpError{Op: op, Type: typ, Err: ErrZeroValue}
+}
+
+// nilErr is like zeroErr, but for types that are invalid when holding nil.
+func nilErr(err error, op, typ string) error {
+	if err != nil {
+		return err
+	}
+	return &OpError{Op: op, Type: typ, Err: ErrNilValue}
+}
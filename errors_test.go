@@ -0,0 +1,143 @@
+package maybe_test
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/xdg/maybe"
+	"github.com/xdg/testy"
+)
+
+func TestElementError(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	var ee *maybe.ElementError
+	atoi := func(s string) maybe.I { return maybe.NewI(strconv.Atoi(s)) }
+
+	// 1-D containers record the index
+	_, err := maybe.JustAoS([]string{"23", "x"}).ToInt(atoi).Unbox()
+	is.True(errors.As(err, &ee))
+	is.Equal(ee.Index, []int{1})
+	is.True(errors.Is(err, strconv.ErrSyntax))
+
+	// 2-D containers record the row and column
+	_, err = maybe.JustAoAoS([][]string{{"23", "42"}, {"11", "x"}}).ToInt(atoi).Unbox()
+	is.True(errors.As(err, &ee))
+	is.Equal(ee.Index, []int{1, 1})
+	is.Equal(err.Error(), "element [1 1]: strconv.Atoi: parsing \"x\": invalid syntax")
+
+	// Row-wise operations record the row
+	bad := errors.New("bad row")
+	_, err = maybe.JustAoAoI([][]int{{1}, {2}}).Join(func(xs []int) maybe.I {
+		if xs[0] == 2 {
+			return maybe.ErrI(bad)
+		}
+		return maybe.JustI(xs[0])
+	}).Unbox()
+	is.True(errors.As(err, &ee))
+	is.Equal(ee.Index, []int{1})
+	is.Equal(errors.Unwrap(err), bad)
+
+	// Split records the element that was split
+	_, err = maybe.JustAoI([]int{1, 2}).Split(func(x int) maybe.AoI { return maybe.ErrAoI(bad) }).Unbox()
+	is.True(errors.As(err, &ee))
+	is.Equal(ee.Index, []int{0})
+
+	// Map containers record the key instead
+	var ke *maybe.KeyError
+	_, err = maybe.JustMoI(map[string]int{"a": 1, "b": 2}).MapValues(func(x int) maybe.I {
+		if x == 2 {
+			return maybe.ErrI(bad)
+		}
+		return maybe.JustI(x)
+	}).Unbox()
+	is.True(errors.As(err, &ke))
+	is.Equal(ke.Key, "b")
+	is.Equal(errors.Unwrap(err), bad)
+	is.Equal(err.Error(), `key "b": bad row`)
+
+	// Errors of invalid inputs are passed through untouched
+	_, err = maybe.ErrAoS(bad).ToInt(atoi).Unbox()
+	is.Equal(err, bad)
+}
+
+func TestOpError(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	var oe *maybe.OpError
+
+	// Unboxing a zero value
+	_, err := maybe.AoI{}.Unbox()
+	is.True(errors.Is(err, maybe.ErrZeroValue))
+	is.True(errors.As(err, &oe))
+	is.Equal(oe.Op, "Unbox")
+	is.Equal(oe.Type, "AoI")
+	is.Equal(err.Error(), "AoI.Unbox: zero value")
+
+	// A zero value propagates as invalid, even to scalar types
+	sum := func(xs []int) maybe.I { return maybe.JustI(len(xs)) }
+	got := maybe.AoI{}.Join(sum)
+	is.True(got.IsErr())
+	_, err = got.Unbox()
+	is.True(errors.As(err, &oe))
+	is.Equal(oe.Op, "Join")
+	is.Equal(oe.Type, "AoI")
+	is.True(errors.Is(err, maybe.ErrZeroValue))
+
+	_, err = maybe.AoAoS{}.ToInt(func(s string) maybe.I { return maybe.JustI(0) }).Unbox()
+	is.True(errors.Is(err, maybe.ErrZeroValue))
+	is.Equal(err.Error(), "AoAoS.ToInt: zero value")
+
+	// Named types report their own method, not the generic function behind it
+	errOf := func(_ interface{}, err error) error { return err }
+	double := func(x int) maybe.I { return maybe.JustI(2 * x) }
+	count := func(xs []int) maybe.I { return maybe.JustI(len(xs)) }
+	atoi := func(s string) maybe.I { return maybe.NewI(strconv.Atoi(s)) }
+	cases := []struct {
+		err     error
+		op, typ string
+	}{
+		{errOf(maybe.AoI{}.Map(double).Unbox()), "Map", "AoI"},
+		{errOf(maybe.AoAoI{}.Join(count).Unbox()), "Join", "AoAoI"},
+		{errOf(maybe.AoS{}.ToInt(atoi).Unbox()), "ToInt", "AoS"},
+		{errOf(maybe.MoI{}.Get("k").Unbox()), "Get", "MoI"},
+		{errOf(maybe.MoS{}.Keys().Unbox()), "Keys", "MoS"},
+		{errOf(maybe.AoI{}.Zip(maybe.JustAoI([]int{1})).Unbox()), "Zip", "AoI"},
+		{errOf(maybe.JustAoI([]int{1}).Zip(maybe.AoI{}).Unbox()), "Zip", "AoI"},
+		{errOf(maybe.AoI{}.Seq().Collect().Unbox()), "Seq", "AoI"},
+		{errOf(maybe.MoX{}.MapErr(func(err error) error { return nil }).Unbox()), "Unbox", "MoX"},
+		{errOf(maybe.JustAoI([]int{1}).Split(func(int) maybe.AoI { return maybe.AoI{} }).Unbox()), "Unbox", "AoI"},
+		{errOf(maybe.JustAoAoI([][]int{{1}}).Bind(func([][]int) maybe.AoAoI { return maybe.AoAoI{} }).Unbox()), "Unbox", "AoAoI"},
+	}
+	for _, c := range cases {
+		var oe *maybe.OpError
+		is.True(errors.Is(c.err, maybe.ErrZeroValue))
+		is.True(errors.As(c.err, &oe))
+		is.Equal(oe.Op+" "+oe.Type, c.op+" "+c.typ)
+	}
+
+	// Nil values
+	_, err = maybe.JustX(nil).Unbox()
+	is.True(errors.Is(err, maybe.ErrNilValue))
+	is.Equal(err.Error(), "X.Unbox: nil value")
+	_, err = maybe.X{}.Split(func(x interface{}) maybe.AoX { return maybe.JustAoX(nil) }).Unbox()
+	is.True(errors.Is(err, maybe.ErrNilValue))
+	_, err = maybe.JustBigI(nil).ToI64().Unbox()
+	is.True(errors.Is(err, maybe.ErrNilValue))
+
+	// Non-slices
+	_, err = maybe.NewAoXFromSlice(42, nil).Unbox()
+	is.True(errors.Is(err, maybe.ErrNotSlice))
+	is.Equal(err.Error(), "AoX.NewAoXFromSlice: not a slice")
+	_, err = maybe.NewAoAoXFromSlice([]int{42}, nil).Unbox()
+	is.True(errors.Is(err, maybe.ErrNotSlice))
+
+	// Callback errors are passed through unchanged
+	bad := errors.New("bad")
+	_, err = maybe.ErrAoI(bad).Join(sum).Unbox()
+	is.Equal(err, bad)
+	is.False(errors.As(err, &oe))
+}
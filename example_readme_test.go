@@ -55,6 +55,6 @@ func Example() {
 
 	// Output:
 	// success: Just [23 42 0] converted to Just [23 42 0]
-	// bad atoi: Just [23 forty-two 0] failed to convert: Err strconv.Atoi: parsing "forty-two": invalid syntax
-	// negative: Just [23 -42 0] failed to convert: Err -42 is negative
+	// bad atoi: Just [23 forty-two 0] failed to convert: Err element [1]: strconv.Atoi: parsing "forty-two": invalid syntax
+	// negative: Just [23 -42 0] failed to convert: Err element [1]: -42 is negative
 }
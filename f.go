@@ -0,0 +1,129 @@
+package maybe
+
+import "fmt"
+
+// F implements the Maybe monad for a float64.  An F is considered 'valid' or
+// 'invalid' depending on whether it contains a float64 or an error value.
+type F Maybe[float64]
+
+// NewF constructs an F from a given float64 or error. If e is not nil, returns
+// ErrF(e), otherwise returns JustF(x).
+func NewF(x float64, e error) F {
+	if e != nil {
+		return ErrF(e)
+	}
+	return JustF(x)
+}
+
+// JustF constructs a valid F from a given float64.
+func JustF(x float64) F {
+	return F{just: x}
+}
+
+// ErrF constructs an invalid F from a given error.
+func ErrF(e error) F {
+	return F{err: e}
+}
+
+// NothingF constructs an invalid F that holds nothing, for a missing rather
+// than a failed value.  See ErrNothing.
+func NothingF() F {
+	return F{err: ErrNothing}
+}
+
+// IsErr returns true for an invalid F.
+func (m F) IsErr() bool {
+	return m.err != nil
+}
+
+// Bind applies a function that takes a float64 and returns an F.
+func (m F) Bind(f func(x float64) F) F {
+	if m.err != nil {
+		return m
+	}
+
+	return try(f, m.just, ErrF)
+}
+
+// Split applies a function that takes a float64 and returns an AoF.
+func (m F) Split(f func(x float64) AoF) AoF {
+	if m.err != nil {
+		return ErrAoF(m.err)
+	}
+
+	return try(f, m.just, ErrAoF)
+}
+
+// ToStr applies a function that takes a float64 and returns an S.
+func (m F) ToStr(f func(x float64) S) S {
+	if m.err != nil {
+		return ErrS(m.err)
+	}
+
+	return try(f, m.just, ErrS)
+}
+
+// IsNothing returns true for an F that holds nothing, i.e. one whose error is
+// ErrNothing itself.  Such an F is also invalid, so IsErr returns true.
+func (m F) IsNothing() bool {
+	return Maybe[float64](m).IsNothing()
+}
+
+// JustOr returns a valid F holding a default value if the F holds nothing, or
+// otherwise returns the F unchanged.  Unlike OrElse, it leaves other errors in
+// place.
+func (m F) JustOr(x float64) F {
+	return F(Maybe[float64](m).JustOr(x))
+}
+
+// OrElse returns the F if it is valid, or otherwise a valid F holding a default
+// value.
+func (m F) OrElse(x float64) F {
+	return F(Maybe[float64](m).OrElse(x))
+}
+
+// Recover returns the F if it is valid, or otherwise a valid F holding the
+// value returned by a function that takes the error.
+func (m F) Recover(f func(err error) float64) F {
+	return F(Maybe[float64](m).Recover(f))
+}
+
+// MapErr returns the F if it is valid, or otherwise an invalid F with the
+// error returned by a function that takes the original error, e.g. to add
+// context with fmt.Errorf and %w.  If the function returns nil, the original
+// error is kept.
+func (m F) MapErr(f func(err error) error) F {
+	return F(Maybe[float64](m).MapErr(f))
+}
+
+// Catch is like Recover, but only for errors that match a target according
+// to errors.Is, and the function returns an F, so it may fail again.  Valid
+// values and other errors are returned unchanged.
+func (m F) Catch(target error, f func(err error) F) F {
+	return F(Maybe[float64](m).Catch(target, toMaybe(f)))
+}
+
+// MarshalJSON implements json.Marshaler using the wire format described in
+// the package documentation.
+func (m F) MarshalJSON() ([]byte, error) {
+	return marshalJSON(m.Unbox())
+}
+
+// UnmarshalJSON implements json.Unmarshaler using the wire format described
+// in the package documentation.
+func (m *F) UnmarshalJSON(b []byte) error {
+	return unmarshalJSON(b, (*Maybe[float64])(m), "F")
+}
+
+// String returns a string representation, mostly useful for debugging.
+func (m F) String() string {
+	if m.err != nil {
+		return fmt.Sprintf("Err %v", m.err)
+	}
+	return fmt.Sprintf("Just %v", m.just)
+}
+
+// Unbox returns the underlying float64 value or error.
+func (m F) Unbox() (float64, error) {
+	return m.just, m.err
+}
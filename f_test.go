@@ -0,0 +1,98 @@
+package maybe_test
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/xdg/maybe"
+	"github.com/xdg/testy"
+)
+
+func TestFloat(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	var good, bad, got maybe.F
+	var just float64
+	var err error
+
+	good = maybe.JustF(4.2)
+	just, err = good.Unbox()
+	is.Equal(just, 4.2)
+	is.Nil(err)
+	is.False(good.IsErr())
+
+	bad = maybe.ErrF(errors.New("bad float"))
+	just, err = bad.Unbox()
+	is.Equal(just, 0.0)
+	is.NotNil(err)
+	is.Equal(err.Error(), "bad float")
+	is.True(bad.IsErr())
+
+	got = maybe.NewF(4.2, nil)
+	is.Equal(got, good)
+
+	got = maybe.NewF(0, err)
+	is.True(got.IsErr())
+
+	is.Equal(good.String(), "Just 4.2")
+	is.Equal(bad.String(), "Err bad float")
+}
+
+func TestFloatBindSplit(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	good := maybe.JustF(4.25)
+	bad := maybe.ErrF(errors.New("bad float"))
+
+	sqrt := func(x float64) maybe.F {
+		if x < 0 {
+			return maybe.ErrF(errors.New("negative"))
+		}
+		return maybe.JustF(math.Sqrt(x))
+	}
+	just, err := maybe.JustF(16).Bind(sqrt).Unbox()
+	is.Equal(just, 4.0)
+	is.Nil(err)
+	is.True(maybe.JustF(-1).Bind(sqrt).IsErr())
+	is.True(bad.Bind(sqrt).IsErr())
+
+	parts := func(x float64) maybe.AoF {
+		i, frac := math.Modf(x)
+		return maybe.JustAoF([]float64{i, frac})
+	}
+	xs, err := good.Split(parts).Unbox()
+	is.Equal(xs, []float64{4, 0.25})
+	is.Nil(err)
+	is.True(bad.Split(parts).IsErr())
+}
+
+func TestFloatConversions(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	parse := func(s string) maybe.F { return maybe.NewF(strconv.ParseFloat(s, 64)) }
+	format := func(x float64) maybe.S { return maybe.JustS(strconv.FormatFloat(x, 'f', -1, 64)) }
+	toFloat := func(x int) maybe.F { return maybe.JustF(float64(x)) }
+
+	// S to F and back
+	just, err := maybe.JustS("4.2").ToFloat(parse).Unbox()
+	is.Equal(just, 4.2)
+	is.Nil(err)
+	is.True(maybe.JustS("four").ToFloat(parse).IsErr())
+	is.True(maybe.ErrS(errors.New("bad string")).ToFloat(parse).IsErr())
+
+	s, err := maybe.JustF(4.2).ToStr(format).Unbox()
+	is.Equal(s, "4.2")
+	is.Nil(err)
+	is.True(maybe.ErrF(errors.New("bad float")).ToStr(format).IsErr())
+
+	// I to F
+	just, err = maybe.JustI(42).ToFloat(toFloat).Unbox()
+	is.Equal(just, 42.0)
+	is.Nil(err)
+	is.True(maybe.ErrI(errors.New("bad int")).ToFloat(toFloat).IsErr())
+}
@@ -0,0 +1,322 @@
+package maybe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// Maybe implements the Maybe monad for an arbitrary type T.  A Maybe is
+// considered 'valid' or 'invalid' depending on whether it contains a T or an
+// error value.
+//
+// The named types in this package are defined in terms of Maybe, so they
+// can be converted to and from the generic form without copying.  For
+// example, `maybe.Maybe[int](i)` turns an I into a Maybe[int] and
+// `maybe.I(m)` turns it back.
+type Maybe[T any] struct {
+	just T
+	err  error
+}
+
+// New constructs a Maybe from a given value or error. If e is not nil,
+// returns Err(e), otherwise returns Just(x).
+func New[T any](x T, e error) Maybe[T] {
+	if e != nil {
+		return Err[T](e)
+	}
+	return Just(x)
+}
+
+// Just constructs a valid Maybe from a given value.
+func Just[T any](x T) Maybe[T] {
+	return Maybe[T]{just: x}
+}
+
+// Err constructs an invalid Maybe from a given error.  As there is no value
+// to infer the type from, it must be given explicitly, e.g. Err[int](e).
+func Err[T any](e error) Maybe[T] {
+	return Maybe[T]{err: e}
+}
+
+// Nothing constructs an invalid Maybe that holds nothing, for a missing rather
+// than a failed value.  See ErrNothing.
+func Nothing[T any]() Maybe[T] {
+	return Maybe[T]{err: ErrNothing}
+}
+
+// FromPtr constructs a Maybe from a pointer: a valid Maybe holding the value
+// it points to, or Nothing if it is nil.  Convert the result to a named type
+// as needed, e.g. `maybe.S(maybe.FromPtr(p))`.
+func FromPtr[T any](p *T) Maybe[T] {
+	if p == nil {
+		return Nothing[T]()
+	}
+	return Just(*p)
+}
+
+// FromOK constructs a Maybe from the comma-ok idiom, e.g. a map lookup or a
+// type assertion: a valid Maybe holding the value if ok is true, or Nothing
+// otherwise.
+func FromOK[T any](x T, ok bool) Maybe[T] {
+	if !ok {
+		return Nothing[T]()
+	}
+	return Just(x)
+}
+
+// IsErr returns true for an invalid Maybe.
+func (m Maybe[T]) IsErr() bool {
+	return m.err != nil
+}
+
+// Bind applies a function that takes a T and returns a Maybe of the same
+// type.  Use the Bind function to change types.
+func (m Maybe[T]) Bind(f func(x T) Maybe[T]) Maybe[T] {
+	if m.err != nil {
+		return m
+	}
+
+	return try(f, m.just, Err[T])
+}
+
+// BindCtx is like Bind, but the function also takes a context.  If the
+// context is done, BindCtx returns an invalid Maybe with the context's error
+// instead of calling the function.
+func (m Maybe[T]) BindCtx(ctx context.Context, f func(ctx context.Context, x T) Maybe[T]) Maybe[T] {
+	return BindCtx(ctx, m, f)
+}
+
+// IsNothing returns true for a Maybe that holds nothing, i.e. one whose error
+// is ErrNothing itself.  Such a Maybe is also invalid, so IsErr returns true.
+func (m Maybe[T]) IsNothing() bool {
+	return m.err == ErrNothing
+}
+
+// JustOr returns a valid Maybe holding a default value if the Maybe holds
+// nothing, or otherwise returns the Maybe unchanged.  Unlike OrElse, it leaves
+// other errors in place.
+func (m Maybe[T]) JustOr(x T) Maybe[T] {
+	if m.IsNothing() {
+		return Just(x)
+	}
+	return m
+}
+
+// OrElse returns the Maybe if it is valid, or otherwise a valid Maybe holding a
+// default value.
+func (m Maybe[T]) OrElse(x T) Maybe[T] {
+	if m.IsErr() {
+		return Just(x)
+	}
+	return m
+}
+
+// Recover returns the Maybe if it is valid, or otherwise a valid Maybe holding
+// the value returned by a function that takes the error.
+func (m Maybe[T]) Recover(f func(err error) T) Maybe[T] {
+	if !m.IsErr() {
+		return m
+	}
+	_, err := m.Unbox()
+	return try(func(err error) Maybe[T] { return Just(f(err)) }, err, Err[T])
+}
+
+// MapErr returns the Maybe if it is valid, or otherwise an invalid Maybe with
+// the error returned by a function that takes the original error, e.g. to add
+// context with fmt.Errorf and %w.  If the function returns nil, the original
+// error is kept.
+func (m Maybe[T]) MapErr(f func(err error) error) Maybe[T] {
+	if !m.IsErr() {
+		return m
+	}
+	_, err := m.Unbox()
+	if e := try(f, err, func(e error) error { return e }); e != nil {
+		return Err[T](e)
+	}
+	return Err[T](err)
+}
+
+// Catch is like Recover, but only for errors that match a target according
+// to errors.Is, and the function returns a Maybe, so it may fail again.  Valid
+// values and other errors are returned unchanged.
+func (m Maybe[T]) Catch(target error, f func(err error) Maybe[T]) Maybe[T] {
+	if !m.IsErr() {
+		return m
+	}
+	_, err := m.Unbox()
+	if !errors.Is(err, target) {
+		return m
+	}
+	return try(f, err, Err[T])
+}
+
+// MarshalJSON implements json.Marshaler using the wire format described in
+// the package documentation.
+func (m Maybe[T]) MarshalJSON() ([]byte, error) {
+	return marshalJSON(m.Unbox())
+}
+
+// UnmarshalJSON implements json.Unmarshaler using the wire format described
+// in the package documentation.
+func (m *Maybe[T]) UnmarshalJSON(b []byte) error {
+	return unmarshalJSON(b, m, "Maybe")
+}
+
+// String returns a string representation, mostly useful for debugging.
+func (m Maybe[T]) String() string {
+	if m.err != nil {
+		return fmt.Sprintf("Err %v", m.err)
+	}
+	return fmt.Sprintf("Just %v", m.just)
+}
+
+// Unbox returns the underlying value or error.
+func (m Maybe[T]) Unbox() (T, error) {
+	return m.just, m.err
+}
+
+// Bind applies a function that takes a T and returns a Maybe[U].  If m is
+// invalid, the error is carried over into the result.
+func Bind[T, U any](m Maybe[T], f func(x T) Maybe[U]) Maybe[U] {
+	if m.err != nil {
+		return Err[U](m.err)
+	}
+
+	return try(f, m.just, Err[U])
+}
+
+// Map applies a function that takes a T and returns a U, boxing the result.
+// It is for functions that can't fail; use Bind for ones that can.
+func Map[T, U any](m Maybe[T], f func(x T) U) Maybe[U] {
+	if m.err != nil {
+		return Err[U](m.err)
+	}
+
+	return try(func(x T) Maybe[U] { return Just(f(x)) }, m.just, Err[U])
+}
+
+// Lift2 applies a function that takes two plain values to the values of two
+// Maybes, or any of the named scalar types such as I and S, boxing the
+// result.  If either argument is invalid, Lift2 returns an invalid Maybe with
+// the error of the first invalid one, without calling the function.  As with
+// Sequence, validity follows each type's own rules.  Convert the result to a
+// named type as needed, e.g. `maybe.I(maybe.Lift2(a, b, f))`.
+func Lift2[A, B, C any, MA ~struct {
+	just A
+	err  error
+}, MB ~struct {
+	just B
+	err  error
+}](a MA, b MB, f func(x A, y B) C) Maybe[C] {
+	ma, mb := Maybe[A](a), Maybe[B](b)
+	if err := argErr[A](a); err != nil {
+		return Err[C](err)
+	}
+	if err := argErr[B](b); err != nil {
+		return Err[C](err)
+	}
+
+	return try(func(x A) Maybe[C] { return Just(f(x, mb.just)) }, ma.just, Err[C])
+}
+
+// Lift3 is like Lift2, but for a function that takes three values.
+func Lift3[A, B, C, D any, MA ~struct {
+	just A
+	err  error
+}, MB ~struct {
+	just B
+	err  error
+}, MC ~struct {
+	just C
+	err  error
+}](a MA, b MB, c MC, f func(x A, y B, z C) D) Maybe[D] {
+	ma, mb, mc := Maybe[A](a), Maybe[B](b), Maybe[C](c)
+	for _, err := range []error{argErr[A](a), argErr[B](b), argErr[C](c)} {
+		if err != nil {
+			return Err[D](err)
+		}
+	}
+
+	return try(func(x A) Maybe[D] { return Just(f(x, mb.just, mc.just)) }, ma.just, Err[D])
+}
+
+// argErr returns the error of a Maybe or named type passed to a generic
+// function, as reported by the argument's own Unbox, so that the validity
+// rules of the named types apply, e.g. that an X holding nil or a zero-value
+// AoI is invalid.
+func argErr[T any, M ~struct {
+	just T
+	err  error
+}](m M) error {
+	if err := unboxErr[T](m); err != nil {
+		return err
+	}
+	return Maybe[T](m).err
+}
+
+// The helpers below adapt callbacks that return one of the named types, so
+// they can be handed to the generic functions implementing those types.  The
+// container adapters check results with their own Unbox, so that a zero value
+// reports its named type, e.g. "AoI.Unbox: zero value".
+
+func unboxErr[U any](m interface{}) error {
+	if u, ok := m.(interface{ Unbox() (U, error) }); ok {
+		_, err := u.Unbox()
+		return err
+	}
+	return nil
+}
+
+func toMaybe[T, U any, M ~struct {
+	just U
+	err  error
+}](f func(x T) M) func(x T) Maybe[U] {
+	return func(x T) Maybe[U] { return Maybe[U](f(x)) }
+}
+
+func toMaybe2[A, T, U any, M ~struct {
+	just U
+	err  error
+}](f func(a A, x T) M) func(a A, x T) Maybe[U] {
+	return func(a A, x T) Maybe[U] { return Maybe[U](f(a, x)) }
+}
+
+func toSlice[T, U any, M ~struct {
+	just []U
+	err  error
+}](f func(x T) M) func(x T) Slice[U] {
+	return func(x T) Slice[U] {
+		y := f(x)
+		if err := unboxErr[[]U](y); err != nil {
+			return ErrSlice[U](err)
+		}
+		return Slice[U](y)
+	}
+}
+
+func toGrid[T, U any, M ~struct {
+	just [][]U
+	err  error
+}](f func(x T) M) func(x T) Grid[U] {
+	return func(x T) Grid[U] {
+		y := f(x)
+		if err := unboxErr[[][]U](y); err != nil {
+			return ErrGrid[U](err)
+		}
+		return Grid[U](y)
+	}
+}
+
+func toDict[T, U any, M ~struct {
+	just map[string]U
+	err  error
+}](f func(x T) M) func(x T) Dict[U] {
+	return func(x T) Dict[U] {
+		y := f(x)
+		if err := unboxErr[map[string]U](y); err != nil {
+			return ErrDict[U](err)
+		}
+		return Dict[U](y)
+	}
+}
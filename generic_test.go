@@ -0,0 +1,231 @@
+package maybe_test
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/xdg/maybe"
+	"github.com/xdg/testy"
+)
+
+func TestMaybe(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	var good, bad, got maybe.Maybe[float64]
+	var just float64
+	var err error
+
+	good = maybe.Just(1.5)
+	just, err = good.Unbox()
+	is.Equal(just, 1.5)
+	is.Nil(err)
+	is.False(good.IsErr())
+
+	bad = maybe.Err[float64](errors.New("bad float"))
+	just, err = bad.Unbox()
+	is.Equal(just, 0.0)
+	is.NotNil(err)
+	is.Equal(err.Error(), "bad float")
+	is.True(bad.IsErr())
+
+	got = maybe.New(1.5, nil)
+	is.Equal(got, good)
+
+	got = maybe.New(0.0, err)
+	is.True(got.IsErr())
+
+	is.Equal(good.String(), "Just 1.5")
+	is.Equal(bad.String(), "Err bad float")
+}
+
+func TestMaybeBind(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	good := maybe.Just("42")
+	bad := maybe.Err[string](errors.New("bad string"))
+
+	// Bind method keeps the type
+	f := func(s string) maybe.Maybe[string] { return maybe.Just(s + "!") }
+	just, err := good.Bind(f).Unbox()
+	is.Equal(just, "42!")
+	is.Nil(err)
+	is.True(bad.Bind(f).IsErr())
+
+	// Bind function changes the type
+	atoi := func(s string) maybe.Maybe[int] { return maybe.New(strconv.Atoi(s)) }
+	n, err := maybe.Bind(good, atoi).Unbox()
+	is.Equal(n, 42)
+	is.Nil(err)
+	is.True(maybe.Bind(bad, atoi).IsErr())
+	is.True(maybe.Bind(maybe.Just("forty-two"), atoi).IsErr())
+}
+
+func TestMaybeMap(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	good := maybe.Just(42)
+	bad := maybe.Err[int](errors.New("bad int"))
+
+	got := maybe.Map(good, strconv.Itoa)
+	just, err := got.Unbox()
+	is.Equal(just, "42")
+	is.Nil(err)
+
+	got = maybe.Map(bad, strconv.Itoa)
+	_, err = got.Unbox()
+	is.Equal(err.Error(), "bad int")
+}
+
+func TestMaybeNamedConversion(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	// Named types convert to and from the generic form
+	i := maybe.JustI(42)
+	m := maybe.Maybe[int](i)
+	is.Equal(m, maybe.Just(42))
+
+	s := maybe.S(maybe.Map(m, strconv.Itoa))
+	is.Equal(s, maybe.JustS("42"))
+
+	back := maybe.I(maybe.Bind(maybe.Maybe[string](s), func(s string) maybe.Maybe[int] {
+		return maybe.New(strconv.Atoi(s))
+	}))
+	is.Equal(back, i)
+
+	xs := maybe.Maybe[[]int](maybe.JustAoI([]int{1, 2}))
+	is.Equal(maybe.AoI(xs), maybe.JustAoI([]int{1, 2}))
+}
+
+func TestMaybeRecovery(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	errMissing := errors.New("missing")
+	good := maybe.Just(42)
+	bad := maybe.Err[int](fmt.Errorf("lookup: %w", errMissing))
+	other := maybe.Err[int](errors.New("other"))
+
+	is.Equal(good.OrElse(0), good)
+	is.Equal(bad.OrElse(0), maybe.Just(0))
+
+	length := func(err error) int { return len(err.Error()) }
+	is.Equal(good.Recover(length), good)
+	is.Equal(bad.Recover(length), maybe.Just(15))
+
+	wrap := func(err error) error { return fmt.Errorf("config: %w", err) }
+	is.Equal(good.MapErr(wrap), good)
+	_, err := bad.MapErr(wrap).Unbox()
+	is.Equal(err.Error(), "config: lookup: missing")
+	is.True(errors.Is(err, errMissing))
+	_, err = bad.MapErr(func(err error) error { return nil }).Unbox()
+	is.Equal(err.Error(), "lookup: missing")
+
+	fallback := func(err error) maybe.Maybe[int] { return maybe.Just(-1) }
+	is.Equal(good.Catch(errMissing, fallback), good)
+	is.Equal(bad.Catch(errMissing, fallback), maybe.Just(-1))
+	is.Equal(other.Catch(errMissing, fallback), other)
+
+	refail := func(err error) maybe.Maybe[int] { return maybe.Err[int](errors.New("still missing")) }
+	_, err = bad.Catch(errMissing, refail).Unbox()
+	is.Equal(err.Error(), "still missing")
+}
+
+func TestLift(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	add := func(x, y int) int { return x + y }
+	label := func(s string, n int) string { return s + "=" + strconv.Itoa(n) }
+
+	is.Equal(maybe.Lift2(maybe.Just(1), maybe.Just(2), add), maybe.Just(3))
+	is.Equal(maybe.I(maybe.Lift2(maybe.JustI(1), maybe.JustI(2), add)), maybe.JustI(3))
+	is.Equal(maybe.S(maybe.Lift2(maybe.JustS("n"), maybe.JustI(7), label)), maybe.JustS("n=7"))
+
+	// The first error wins and the function isn't called
+	called := false
+	noop := func(x, y int) int { called = true; return 0 }
+	_, err := maybe.Lift2(maybe.ErrI(errors.New("left")), maybe.ErrI(errors.New("right")), noop).Unbox()
+	is.Equal(err.Error(), "left")
+	_, err = maybe.Lift2(maybe.JustI(1), maybe.ErrI(errors.New("right")), noop).Unbox()
+	is.Equal(err.Error(), "right")
+	is.False(called)
+
+	// An X holding nil is invalid, as for X.Bind
+	pair := func(x interface{}, y int) int { called = true; return y }
+	_, err = maybe.Lift2(maybe.JustX(nil), maybe.JustI(1), pair).Unbox()
+	is.True(errors.Is(err, maybe.ErrNilValue))
+	is.False(called)
+	is.Equal(maybe.Lift2(maybe.Just[interface{}](nil), maybe.JustI(1), pair), maybe.Just(1))
+	_, err = maybe.Lift2(maybe.AoI{}, maybe.JustI(1), func(xs []int, y int) int { called = true; return y }).Unbox()
+	is.Equal(err.Error(), "AoI.Unbox: zero value")
+
+	clamp := func(x, lo, hi int) int {
+		if x < lo {
+			return lo
+		}
+		if x > hi {
+			return hi
+		}
+		return x
+	}
+	is.Equal(maybe.I(maybe.Lift3(maybe.JustI(15), maybe.JustI(0), maybe.JustI(10), clamp)), maybe.JustI(10))
+	_, err = maybe.Lift3(maybe.JustI(15), maybe.JustI(0), maybe.ErrI(errors.New("no max")), clamp).Unbox()
+	is.Equal(err.Error(), "no max")
+
+	// Panics are recovered when enabled
+	defer maybe.SetRecoverPanics(maybe.SetRecoverPanics(true))
+	div := func(x, y int) int { return x / y }
+	_, err = maybe.Lift2(maybe.JustI(1), maybe.JustI(0), div).Unbox()
+	var pe *maybe.PanicError
+	is.True(errors.As(err, &pe))
+}
+
+func TestMaybeNothing(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	n := maybe.Nothing[int]()
+	is.True(n.IsErr())
+	is.True(n.IsNothing())
+	_, err := n.Unbox()
+	is.Equal(err, maybe.ErrNothing)
+	is.Equal(n.String(), "Err nothing")
+
+	is.False(maybe.Just(0).IsNothing())
+	is.False(maybe.Err[int](errors.New("bad")).IsNothing())
+	is.False(maybe.Maybe[int]{}.IsNothing())
+
+	x := 42
+	is.Equal(maybe.FromPtr(&x), maybe.Just(42))
+	is.True(maybe.FromPtr[int](nil).IsNothing())
+	is.Equal(maybe.FromOK(x, true), maybe.Just(42))
+	is.True(maybe.FromOK(x, false).IsNothing())
+	var v interface{} = "str"
+	s, ok := v.(string)
+	is.Equal(maybe.S(maybe.FromOK(s, ok)), maybe.JustS("str"))
+
+	// JustOr fills in Nothing but leaves errors alone
+	is.Equal(n.JustOr(7), maybe.Just(7))
+	is.Equal(maybe.Just(1).JustOr(7), maybe.Just(1))
+	is.True(maybe.Err[int](errors.New("bad")).JustOr(7).IsErr())
+
+	// Nothing passes through without calling functions
+	called := false
+	inc := func(x int) maybe.Maybe[int] { called = true; return maybe.Just(x + 1) }
+	is.True(n.Bind(inc).IsNothing())
+	is.True(maybe.Bind(n, inc).IsNothing())
+	is.True(maybe.Map(n, strconv.Itoa).IsNothing())
+	is.True(maybe.Lift2(maybe.Just(1), n, func(x, y int) int { return x + y }).IsNothing())
+	is.False(called)
+
+	// Functions can return Nothing too, but context added with %w makes it an
+	// ordinary error
+	is.True(maybe.Just(1).Bind(func(x int) maybe.Maybe[int] { return maybe.Nothing[int]() }).IsNothing())
+	is.False(n.MapErr(func(err error) error { return fmt.Errorf("field x: %w", err) }).IsNothing())
+}
@@ -0,0 +1,3 @@
+module github.com/xdg/maybe
+
+go 1.23
@@ -0,0 +1,525 @@
+package maybe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// Grid implements the Maybe monad for a 2-D slice of an arbitrary type T.  A
+// Grid is considered 'valid' or 'invalid' depending on whether it contains a
+// 2-D slice or an error value.  A zero-value Grid is invalid and Unbox() will
+// return an error to that effect.
+//
+// AoAoI, AoAoS and AoAoX are defined on top of Grid and convert to and from
+// it without copying, e.g. `maybe.Grid[int](xss)` and `maybe.AoAoI(g)`.
+type Grid[T any] struct {
+	just [][]T
+	err  error
+}
+
+// NewGrid constructs a Grid from a given 2-D slice or error.  If e is not
+// nil, returns ErrGrid(e), otherwise returns JustGrid(x).
+func NewGrid[T any](x [][]T, e error) Grid[T] {
+	if e != nil {
+		return ErrGrid[T](e)
+	}
+	return JustGrid(x)
+}
+
+// JustGrid constructs a valid Grid from a given 2-D slice.
+func JustGrid[T any](x [][]T) Grid[T] {
+	return Grid[T]{just: x}
+}
+
+// ErrGrid constructs an invalid Grid from a given error.
+func ErrGrid[T any](e error) Grid[T] {
+	return Grid[T]{err: e}
+}
+
+// NothingGrid constructs an invalid Grid that holds nothing, for a missing
+// rather than a failed value.  See ErrNothing.
+func NothingGrid[T any]() Grid[T] {
+	return Grid[T]{err: ErrNothing}
+}
+
+// IsErr returns true for an invalid Grid.
+func (m Grid[T]) IsErr() bool {
+	return m.just == nil || m.err != nil
+}
+
+// Bind applies a function that takes a 2-D slice and returns a Grid.
+func (m Grid[T]) Bind(f func(x [][]T) Grid[T]) Grid[T] {
+	return BindGrid(m, f)
+}
+
+// BindCtx is like Bind, but the function also takes a context.  See
+// BindGridCtx for details.
+func (m Grid[T]) BindCtx(ctx context.Context, f func(ctx context.Context, x [][]T) Grid[T]) Grid[T] {
+	return BindGridCtx(ctx, m, f)
+}
+
+// Join applies a function to each row of a valid Grid and returns a Slice of
+// the results.  If the Grid is invalid or if any function returns an invalid
+// Maybe, Join returns an invalid Slice.
+func (m Grid[T]) Join(f func(x []T) Maybe[T]) Slice[T] {
+	return JoinGrid(m, f)
+}
+
+// JoinCtx is like Join, but the function also takes a context.  See
+// JoinGridCtx for details.
+func (m Grid[T]) JoinCtx(ctx context.Context, f func(ctx context.Context, x []T) Maybe[T]) Slice[T] {
+	return JoinGridCtx(ctx, m, f)
+}
+
+// Flatten joins a 2-D slice into a 1-D slice.
+func (m Grid[T]) Flatten() Slice[T] {
+	if m.IsErr() {
+		return ErrSlice[T](zeroErr(m.err, "Flatten", "Grid"))
+	}
+
+	xs := make([]T, 0)
+	for _, v := range m.just {
+		xs = append(xs, v...)
+	}
+
+	return JustSlice(xs)
+}
+
+// Map applies a function to each row of a valid Grid (i.e. a 1-D slice) and
+// returns a new Grid.  If the Grid is invalid or if any function returns an
+// invalid Slice, Map returns an invalid Grid.
+func (m Grid[T]) Map(f func(x []T) Slice[T]) Grid[T] {
+	return MapGrid(m, f)
+}
+
+// MapCtx is like Map, but the function also takes a context.  See
+// MapGridCtx for details.
+func (m Grid[T]) MapCtx(ctx context.Context, f func(ctx context.Context, x []T) Slice[T]) Grid[T] {
+	return MapGridCtx(ctx, m, f)
+}
+
+// JoinAll is like Join, but applies the function to every row even after a
+// failure.  If any function returns an invalid Maybe, JoinAll returns an
+// invalid Slice whose error joins the errors from all failing rows.
+func (m Grid[T]) JoinAll(f func(x []T) Maybe[T]) Slice[T] {
+	return JoinGridAll(m, f)
+}
+
+// MapAll is like Map, but applies the function to every row even after a
+// failure.  If any function returns an invalid Slice, MapAll returns an
+// invalid Grid whose error joins the errors from all failing rows.
+func (m Grid[T]) MapAll(f func(x []T) Slice[T]) Grid[T] {
+	return MapGridAll(m, f)
+}
+
+// ParallelMap is like Map, but runs the function on up to n rows
+// concurrently.  See ParallelMapGrid for details.
+func (m Grid[T]) ParallelMap(n int, f func(x []T) Slice[T]) Grid[T] {
+	return ParallelMapGrid(m, n, f)
+}
+
+// Filter returns a Grid of the rows of a valid Grid for which a function
+// returns true, in order.  If the Grid is invalid or if any function returns
+// an invalid Maybe, Filter returns an invalid Grid.
+func (m Grid[T]) Filter(f func(x []T) Maybe[bool]) Grid[T] {
+	in, _ := partitionGrid(m, f, "Filter")
+	return in
+}
+
+// Reject is the opposite of Filter: it returns a Grid of the rows of a valid
+// Grid for which a function returns false.
+func (m Grid[T]) Reject(f func(x []T) Maybe[bool]) Grid[T] {
+	_, out := partitionGrid(m, f, "Reject")
+	return out
+}
+
+// Partition splits a valid Grid into the rows for which a function returns
+// true and those for which it returns false, both in order.  If the Grid is
+// invalid or if any function returns an invalid Maybe, both results are
+// invalid.
+func (m Grid[T]) Partition(f func(x []T) Maybe[bool]) (Grid[T], Grid[T]) {
+	return partitionGrid(m, f, "Partition")
+}
+
+// FoldRows folds each row of a valid Grid into a single value.  See
+// FoldGridRows for details.
+func (m Grid[T]) FoldRows(init T, f func(acc T, x T) Maybe[T]) Slice[T] {
+	return FoldGridRows(m, init, f)
+}
+
+// FoldCols folds each column of a valid Grid into a single value.  See
+// FoldGridCols for details.
+func (m Grid[T]) FoldCols(init T, f func(acc T, x T) Maybe[T]) Slice[T] {
+	return FoldGridCols(m, init, f)
+}
+
+// Unzip is the opposite of Slice.Zip: it splits a valid Grid whose rows
+// each hold exactly two elements into a Slice of the first elements and a
+// Slice of the second elements.  If the Grid is invalid or any row has a
+// different length, both results are invalid.
+func (m Grid[T]) Unzip() (Slice[T], Slice[T]) {
+	if m.IsErr() {
+		err := zeroErr(m.err, "Unzip", "Grid")
+		return ErrSlice[T](err), ErrSlice[T](err)
+	}
+
+	xs, ys := make([]T, len(m.just)), make([]T, len(m.just))
+	for i, row := range m.just {
+		if len(row) != 2 {
+			err := elemErr(fmt.Errorf("row has %d elements, want 2", len(row)), i)
+			return ErrSlice[T](err), ErrSlice[T](err)
+		}
+		xs[i], ys[i] = row[0], row[1]
+	}
+
+	return JustSlice(xs), JustSlice(ys)
+}
+
+// IsNothing returns true for a Grid that holds nothing, i.e. one whose error is
+// ErrNothing itself.  Such a Grid is also invalid, so IsErr returns true.  An
+// error that only wraps ErrNothing, such as an ElementError for a missing
+// element, is an ordinary error.
+func (m Grid[T]) IsNothing() bool {
+	return m.err == ErrNothing
+}
+
+// JustOr returns a valid Grid holding a default value if the Grid holds
+// nothing, or otherwise returns the Grid unchanged.  Unlike OrElse, it leaves
+// other errors in place.  A nil default is replaced by an empty one, as for
+// OrElse.
+func (m Grid[T]) JustOr(x [][]T) Grid[T] {
+	if m.IsNothing() {
+		return JustGrid(orEmpty(x))
+	}
+	return m
+}
+
+// OrElse returns the Grid if it is valid, or otherwise a valid Grid holding a
+// default value.  A nil default is replaced by an empty one, so the result is
+// always valid.
+func (m Grid[T]) OrElse(x [][]T) Grid[T] {
+	if m.IsErr() {
+		return JustGrid(orEmpty(x))
+	}
+	return m
+}
+
+// Recover returns the Grid if it is valid, or otherwise a valid Grid holding
+// the value returned by a function that takes the error, with nil replaced by
+// an empty value as for OrElse.
+func (m Grid[T]) Recover(f func(err error) [][]T) Grid[T] {
+	if !m.IsErr() {
+		return m
+	}
+	_, err := m.Unbox()
+	return try(func(err error) Grid[T] { return JustGrid(orEmpty(f(err))) }, err, ErrGrid[T])
+}
+
+// MapErr returns the Grid if it is valid, or otherwise an invalid Grid with the
+// error returned by a function that takes the original error, e.g. to add
+// context with fmt.Errorf and %w.  If the function returns nil, the original
+// error is kept.
+func (m Grid[T]) MapErr(f func(err error) error) Grid[T] {
+	if !m.IsErr() {
+		return m
+	}
+	_, err := m.Unbox()
+	if e := try(f, err, func(e error) error { return e }); e != nil {
+		return ErrGrid[T](e)
+	}
+	return ErrGrid[T](err)
+}
+
+// Catch is like Recover, but only for errors that match a target according
+// to errors.Is, and the function returns a Grid, so it may fail again.  Valid
+// values and other errors are returned unchanged.
+func (m Grid[T]) Catch(target error, f func(err error) Grid[T]) Grid[T] {
+	if !m.IsErr() {
+		return m
+	}
+	_, err := m.Unbox()
+	if !errors.Is(err, target) {
+		return m
+	}
+	return try(f, err, ErrGrid[T])
+}
+
+// MarshalJSON implements json.Marshaler using the wire format described in
+// the package documentation.
+func (m Grid[T]) MarshalJSON() ([]byte, error) {
+	return marshalJSON(m.Unbox())
+}
+
+// UnmarshalJSON implements json.Unmarshaler using the wire format described
+// in the package documentation.
+func (m *Grid[T]) UnmarshalJSON(b []byte) error {
+	return unmarshalJSON(b, (*Maybe[[][]T])(m), "Grid")
+}
+
+// String returns a string representation, mostly useful for debugging.
+func (m Grid[T]) String() string {
+	if m.IsErr() {
+		return fmt.Sprintf("Err %v", m.err)
+	}
+	return fmt.Sprintf("Just %v", m.just)
+}
+
+// checkZero turns a zero-value Grid into an invalid one whose error names
+// the given operation and type, so that a named type delegating to a generic
+// function reports its own method rather than the generic one.
+func (m Grid[T]) checkZero(op, typ string) Grid[T] {
+	if m.just == nil && m.err == nil {
+		return ErrGrid[T](&OpError{Op: op, Type: typ, Err: ErrZeroValue})
+	}
+	return m
+}
+
+// Unbox returns the underlying 2-D slice or error.
+func (m Grid[T]) Unbox() ([][]T, error) {
+	if m.just == nil && m.err == nil {
+		return nil, &OpError{Op: "Unbox", Type: "Grid", Err: ErrZeroValue}
+	}
+	return m.just, m.err
+}
+
+// BindGrid applies a function that takes a 2-D slice of T and returns a
+// Grid[U].
+func BindGrid[T, U any](m Grid[T], f func(x [][]T) Grid[U]) Grid[U] {
+	if m.IsErr() {
+		return ErrGrid[U](zeroErr(m.err, "BindGrid", "Grid"))
+	}
+
+	return try(f, m.just, ErrGrid[U])
+}
+
+// JoinGrid applies a function to each row of a valid Grid[T] and returns a
+// Slice[U] of the results.  If the Grid is invalid or if any function returns
+// an invalid Maybe, JoinGrid returns an invalid Slice.
+func JoinGrid[T, U any](m Grid[T], f func(x []T) Maybe[U]) Slice[U] {
+	if m.IsErr() {
+		return ErrSlice[U](zeroErr(m.err, "JoinGrid", "Grid"))
+	}
+
+	xs := make([]U, len(m.just))
+	for i, v := range m.just {
+		x, err := try(f, v, Err[U]).Unbox()
+		if err != nil {
+			return ErrSlice[U](elemErr(err, i))
+		}
+		xs[i] = x
+	}
+
+	return JustSlice(xs)
+}
+
+// MapGrid applies a function to each row of a valid Grid[T] and returns a
+// Grid[U].  If the Grid is invalid or if any function returns an invalid
+// Slice, MapGrid returns an invalid Grid.
+func MapGrid[T, U any](m Grid[T], f func(x []T) Slice[U]) Grid[U] {
+	if m.IsErr() {
+		return ErrGrid[U](zeroErr(m.err, "MapGrid", "Grid"))
+	}
+
+	xss := make([][]U, len(m.just))
+	for i, v := range m.just {
+		xs, err := try(f, v, ErrSlice[U]).Unbox()
+		if err != nil {
+			return ErrGrid[U](elemErr(err, i))
+		}
+		xss[i] = xs
+	}
+
+	return JustGrid(xss)
+}
+
+// MapCells applies a function to each individual element of a valid Grid[T]
+// and returns a Grid[U] of the same shape.  If the Grid is invalid or if any
+// function returns an invalid Maybe, MapCells returns an invalid Grid.
+func MapCells[T, U any](m Grid[T], f func(x T) Maybe[U]) Grid[U] {
+	if m.IsErr() {
+		return ErrGrid[U](zeroErr(m.err, "MapCells", "Grid"))
+	}
+
+	xss := make([][]U, len(m.just))
+	for i, xs := range m.just {
+		xss[i] = make([]U, len(xs))
+		for j, v := range xs {
+			x, err := try(f, v, Err[U]).Unbox()
+			if err != nil {
+				return ErrGrid[U](elemErr(err, i, j))
+			}
+			xss[i][j] = x
+		}
+	}
+
+	return JustGrid(xss)
+}
+
+// JoinGridAll is like JoinGrid, but applies the function to every row even
+// after a failure.  If any function returns an invalid Maybe, JoinGridAll
+// returns an invalid Slice whose error joins the errors from all failing
+// rows, each tagged with its row index.
+func JoinGridAll[T, U any](m Grid[T], f func(x []T) Maybe[U]) Slice[U] {
+	if m.IsErr() {
+		return ErrSlice[U](zeroErr(m.err, "JoinGridAll", "Grid"))
+	}
+
+	xs := make([]U, len(m.just))
+	var errs []error
+	for i, v := range m.just {
+		x, err := try(f, v, Err[U]).Unbox()
+		if err != nil {
+			errs = append(errs, elemErr(err, i))
+			continue
+		}
+		xs[i] = x
+	}
+	if errs != nil {
+		return ErrSlice[U](errors.Join(errs...))
+	}
+
+	return JustSlice(xs)
+}
+
+// MapGridAll is like MapGrid, but applies the function to every row even
+// after a failure.  If any function returns an invalid Slice, MapGridAll
+// returns an invalid Grid whose error joins the errors from all failing
+// rows, each tagged with its row index.
+func MapGridAll[T, U any](m Grid[T], f func(x []T) Slice[U]) Grid[U] {
+	if m.IsErr() {
+		return ErrGrid[U](zeroErr(m.err, "MapGridAll", "Grid"))
+	}
+
+	xss := make([][]U, len(m.just))
+	var errs []error
+	for i, v := range m.just {
+		xs, err := try(f, v, ErrSlice[U]).Unbox()
+		if err != nil {
+			errs = append(errs, elemErr(err, i))
+			continue
+		}
+		xss[i] = xs
+	}
+	if errs != nil {
+		return ErrGrid[U](errors.Join(errs...))
+	}
+
+	return JustGrid(xss)
+}
+
+// MapCellsAll is like MapCells, but applies the function to every element
+// even after a failure.  If any function returns an invalid Maybe,
+// MapCellsAll returns an invalid Grid whose error joins the errors from all
+// failing elements, each tagged with its row and column.
+func MapCellsAll[T, U any](m Grid[T], f func(x T) Maybe[U]) Grid[U] {
+	if m.IsErr() {
+		return ErrGrid[U](zeroErr(m.err, "MapCellsAll", "Grid"))
+	}
+
+	xss := make([][]U, len(m.just))
+	var errs []error
+	for i, xs := range m.just {
+		xss[i] = make([]U, len(xs))
+		for j, v := range xs {
+			x, err := try(f, v, Err[U]).Unbox()
+			if err != nil {
+				errs = append(errs, elemErr(err, i, j))
+				continue
+			}
+			xss[i][j] = x
+		}
+	}
+	if errs != nil {
+		return ErrGrid[U](errors.Join(errs...))
+	}
+
+	return JustGrid(xss)
+}
+
+// FoldGridRows folds each row of a valid Grid[T] into a value of type A, as
+// FoldSlice does, resulting in a Slice[A] with one value per row.  If the
+// Grid is invalid or if any function returns an invalid Maybe, FoldGridRows
+// returns an invalid Slice with the row and column of the failing element.
+func FoldGridRows[T, A any](m Grid[T], init A, f func(acc A, x T) Maybe[A]) Slice[A] {
+	if m.IsErr() {
+		return ErrSlice[A](zeroErr(m.err, "FoldGridRows", "Grid"))
+	}
+
+	xs := make([]A, len(m.just))
+	for i, row := range m.just {
+		acc := init
+		for j, v := range row {
+			x, err := try(func(v T) Maybe[A] { return f(acc, v) }, v, Err[A]).Unbox()
+			if err != nil {
+				return ErrSlice[A](elemErr(err, i, j))
+			}
+			acc = x
+		}
+		xs[i] = acc
+	}
+
+	return JustSlice(xs)
+}
+
+// FoldGridCols folds each column of a valid Grid[T] into a value of type A,
+// going down the rows, resulting in a Slice[A] with one value per column.
+// All rows must have the same length; otherwise, FoldGridCols returns an
+// invalid Slice with an error wrapping ErrRagged for the first row that
+// differs from the first row.  If the Grid is invalid or if any function
+// returns an invalid Maybe, FoldGridCols returns an invalid Slice.
+func FoldGridCols[T, A any](m Grid[T], init A, f func(acc A, x T) Maybe[A]) Slice[A] {
+	if m.IsErr() {
+		return ErrSlice[A](zeroErr(m.err, "FoldGridCols", "Grid"))
+	}
+
+	var n int
+	if len(m.just) > 0 {
+		n = len(m.just[0])
+	}
+	for i, row := range m.just {
+		if len(row) != n {
+			err := fmt.Errorf("%w: row %d has %d elements, want %d", ErrRagged, i, len(row), n)
+			return ErrSlice[A](&OpError{Op: "FoldGridCols", Type: "Grid", Err: err})
+		}
+	}
+
+	xs := make([]A, n)
+	for j := range xs {
+		xs[j] = init
+	}
+	for i, row := range m.just {
+		for j, v := range row {
+			x, err := try(func(v T) Maybe[A] { return f(xs[j], v) }, v, Err[A]).Unbox()
+			if err != nil {
+				return ErrSlice[A](elemErr(err, i, j))
+			}
+			xs[j] = x
+		}
+	}
+
+	return JustSlice(xs)
+}
+
+func partitionGrid[T any](m Grid[T], f func(x []T) Maybe[bool], op string) (Grid[T], Grid[T]) {
+	if m.IsErr() {
+		err := zeroErr(m.err, op, "Grid")
+		return ErrGrid[T](err), ErrGrid[T](err)
+	}
+
+	in, out := make([][]T, 0), make([][]T, 0)
+	for i, v := range m.just {
+		ok, err := try(f, v, Err[bool]).Unbox()
+		if err != nil {
+			err = elemErr(err, i)
+			return ErrGrid[T](err), ErrGrid[T](err)
+		}
+		if ok {
+			in = append(in, v)
+		} else {
+			out = append(out, v)
+		}
+	}
+
+	return JustGrid(in), JustGrid(out)
+}
@@ -0,0 +1,198 @@
+package maybe_test
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/xdg/maybe"
+	"github.com/xdg/testy"
+)
+
+func getGridFixtures(input [][]float64) (good, bad maybe.Grid[float64]) {
+	good = maybe.JustGrid(input)
+	bad = maybe.ErrGrid[float64](errors.New("bad floats"))
+	return
+}
+
+func TestGrid(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	input := [][]float64{{1.5, 2.5}, {3.5}}
+	good, bad := getGridFixtures(input)
+	var got maybe.Grid[float64]
+	var just [][]float64
+	var err error
+
+	just, err = good.Unbox()
+	is.Equal(just, input)
+	is.Nil(err)
+	is.False(good.IsErr())
+
+	just, err = bad.Unbox()
+	is.Nil(just)
+	is.NotNil(err)
+	is.Equal(err.Error(), "bad floats")
+	is.True(bad.IsErr())
+
+	got = maybe.NewGrid(input, nil)
+	is.Equal(got, good)
+
+	got = maybe.NewGrid[float64](nil, err)
+	is.True(got.IsErr())
+
+	is.Equal(good.String(), "Just [[1.5 2.5] [3.5]]")
+	is.Equal(bad.String(), "Err bad floats")
+
+	// Check zero value case
+	zero := maybe.Grid[float64]{}
+	is.True(zero.IsErr())
+	_, err = zero.Unbox()
+	is.NotNil(err)
+}
+
+func TestGridJoinFlatten(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	good, bad := getGridFixtures([][]float64{{1.5, 2.5}, {3.5}})
+
+	sum := func(xs []float64) maybe.Maybe[float64] {
+		var total float64
+		for _, v := range xs {
+			total += v
+		}
+		return maybe.Just(total)
+	}
+	sums, err := good.Join(sum).Unbox()
+	is.Equal(sums, []float64{4, 3.5})
+	is.Nil(err)
+	is.True(bad.Join(sum).IsErr())
+
+	count := func(xs []float64) maybe.Maybe[int] { return maybe.Just(len(xs)) }
+	counts, err := maybe.JoinGrid(good, count).Unbox()
+	is.Equal(counts, []int{2, 1})
+	is.Nil(err)
+	is.True(maybe.JoinGrid(good, func(xs []float64) maybe.Maybe[int] {
+		return maybe.Err[int](errors.New("no"))
+	}).IsErr())
+
+	flat, err := good.Flatten().Unbox()
+	is.Equal(flat, []float64{1.5, 2.5, 3.5})
+	is.Nil(err)
+	is.True(bad.Flatten().IsErr())
+}
+
+func TestGridMap(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	good, bad := getGridFixtures([][]float64{{1.5, 2.5}, {3.5}})
+
+	first := func(xs []float64) maybe.Slice[float64] { return maybe.JustSlice(xs[0:1]) }
+	firsts, err := good.Map(first).Unbox()
+	is.Equal(firsts, [][]float64{{1.5}, {3.5}})
+	is.Nil(err)
+	is.True(bad.Map(first).IsErr())
+
+	format := func(x float64) maybe.Maybe[string] { return maybe.Just(strconv.FormatFloat(x, 'f', -1, 64)) }
+	strs, err := maybe.MapCells(good, format).Unbox()
+	is.Equal(strs, [][]string{{"1.5", "2.5"}, {"3.5"}})
+	is.Nil(err)
+	is.True(maybe.MapCells(bad, format).IsErr())
+
+	lens := func(xs []float64) maybe.Slice[int] { return maybe.JustSlice([]int{len(xs)}) }
+	got, err := maybe.MapGrid(good, lens).Unbox()
+	is.Equal(got, [][]int{{2}, {1}})
+	is.Nil(err)
+	is.True(maybe.MapGrid(good, func(xs []float64) maybe.Slice[int] {
+		return maybe.ErrSlice[int](errors.New("no"))
+	}).IsErr())
+
+	// Results convert to the named types
+	is.Equal(maybe.AoAoS(maybe.MapCells(good, format)), maybe.JustAoAoS([][]string{{"1.5", "2.5"}, {"3.5"}}))
+}
+
+func TestGridFilter(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	nonEmpty := func(xs []string) maybe.Maybe[bool] { return maybe.Just(len(xs) > 0) }
+	good := maybe.JustGrid([][]string{{"a"}, {}, {"b", "c"}})
+
+	xss, err := good.Filter(nonEmpty).Unbox()
+	is.Equal(xss, [][]string{{"a"}, {"b", "c"}})
+	is.Nil(err)
+
+	xss, err = good.Reject(nonEmpty).Unbox()
+	is.Equal(xss, [][]string{{}})
+	is.Nil(err)
+
+	in, out := good.Partition(nonEmpty)
+	is.Equal(in, maybe.JustGrid([][]string{{"a"}, {"b", "c"}}))
+	is.Equal(out, maybe.JustGrid([][]string{{}}))
+
+	fail := func(xs []string) maybe.Maybe[bool] { return maybe.Err[bool](errors.New("bad row")) }
+	_, err = good.Filter(fail).Unbox()
+	is.Equal(err.Error(), "element [0]: bad row")
+	is.True(maybe.ErrGrid[string](errors.New("bad")).Reject(nonEmpty).IsErr())
+}
+
+func TestGridFold(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	add := func(acc, x int) maybe.Maybe[int] {
+		if x < 0 {
+			return maybe.Err[int](errors.New("negative"))
+		}
+		return maybe.Just(acc + x)
+	}
+	good := maybe.JustGrid([][]int{{1, 2, 3}, {4, 5, 6}})
+
+	is.Equal(good.FoldRows(0, add), maybe.JustSlice([]int{6, 15}))
+	is.Equal(good.FoldCols(0, add), maybe.JustSlice([]int{5, 7, 9}))
+	is.Equal(maybe.JustGrid([][]int{}).FoldCols(0, add), maybe.JustSlice([]int{}))
+
+	// Accumulator can have a different type
+	concat := func(acc string, x int) maybe.Maybe[string] { return maybe.Just(acc + strconv.Itoa(x)) }
+	is.Equal(maybe.FoldGridRows(good, "", concat), maybe.JustSlice([]string{"123", "456"}))
+	is.Equal(maybe.FoldGridCols(good, "", concat), maybe.JustSlice([]string{"14", "25", "36"}))
+
+	// Ragged rows fold by row but not by column
+	ragged := maybe.JustGrid([][]int{{1, 2}, {3}})
+	is.Equal(ragged.FoldRows(0, add), maybe.JustSlice([]int{3, 3}))
+	_, err := ragged.FoldCols(0, add).Unbox()
+	is.True(errors.Is(err, maybe.ErrRagged))
+	is.Equal(err.Error(), "Grid.FoldGridCols: ragged rows: row 1 has 1 elements, want 2")
+
+	// Errors record the row and column of the failing element
+	bad := maybe.JustGrid([][]int{{1, 2}, {3, -4}})
+	_, err = bad.FoldRows(0, add).Unbox()
+	is.Equal(err.Error(), "element [1 1]: negative")
+	_, err = bad.FoldCols(0, add).Unbox()
+	is.Equal(err.Error(), "element [1 1]: negative")
+
+	_, err = maybe.Grid[int]{}.FoldCols(0, add).Unbox()
+	is.Equal(err.Error(), "Grid.FoldGridCols: zero value")
+	is.True(maybe.ErrGrid[int](errors.New("bad")).FoldRows(0, add).IsErr())
+}
+
+func TestGridUnzip(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	xs, ys := maybe.JustGrid([][]int{{1, 2}, {3, 4}}).Unzip()
+	is.Equal(xs, maybe.JustSlice([]int{1, 3}))
+	is.Equal(ys, maybe.JustSlice([]int{2, 4}))
+
+	xs, ys = maybe.JustGrid([][]int{{1, 2}, {3}}).Unzip()
+	_, err := xs.Unbox()
+	is.Equal(err.Error(), "element [1]: row has 1 elements, want 2")
+	is.True(ys.IsErr())
+
+	xs, _ = maybe.Grid[int]{}.Unzip()
+	_, err = xs.Unbox()
+	is.Equal(err.Error(), "Grid.Unzip: zero value")
+}
@@ -1,13 +1,13 @@
 package maybe
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 // I implements the Maybe monad for a int.  An I is considered 'valid' or
 // 'invalid' depending on whether it contains a int or an error value.
-type I struct {
-	just int
-	err  error
-}
+type I Maybe[int]
 
 // NewI constructs an I from a given int or error. If e is not nil, returns
 // ErrI(e), otherwise returns JustI(s)
@@ -28,6 +28,12 @@ func ErrI(e error) I {
 	return I{err: e}
 }
 
+// NothingI constructs an invalid I that holds nothing, for a missing rather
+// than a failed value.  See ErrNothing.
+func NothingI() I {
+	return I{err: ErrNothing}
+}
+
 // IsErr returns true for an invalid I.
 func (m I) IsErr() bool {
 	return m.err != nil
@@ -39,7 +45,21 @@ func (m I) Bind(f func(s int) I) I {
 		return m
 	}
 
-	return f(m.just)
+	return try(f, m.just, ErrI)
+}
+
+// BindCtx is like Bind, but the function also takes a context.  If the
+// context is done, BindCtx returns an invalid I with the context's error
+// instead of calling the function.
+func (m I) BindCtx(ctx context.Context, f func(ctx context.Context, s int) I) I {
+	if m.err != nil {
+		return m
+	}
+	if err := ctx.Err(); err != nil {
+		return ErrI(err)
+	}
+
+	return tryCtx(ctx, f, m.just, ErrI)
 }
 
 // Split applies a function that takes a int and returns an AoI.
@@ -48,7 +68,73 @@ func (m I) Split(f func(s int) AoI) AoI {
 		return ErrAoI(m.err)
 	}
 
-	return f(m.just)
+	return try(f, m.just, ErrAoI)
+}
+
+// SplitCtx is like Split, but the function also takes a context.  If the
+// context is done, SplitCtx returns an invalid AoI with the context's error
+// instead of calling the function.
+func (m I) SplitCtx(ctx context.Context, f func(ctx context.Context, s int) AoI) AoI {
+	if m.err != nil {
+		return ErrAoI(m.err)
+	}
+	if err := ctx.Err(); err != nil {
+		return ErrAoI(err)
+	}
+
+	return tryCtx(ctx, f, m.just, ErrAoI)
+}
+
+// IsNothing returns true for an I that holds nothing, i.e. one whose error is
+// ErrNothing itself.  Such an I is also invalid, so IsErr returns true.
+func (m I) IsNothing() bool {
+	return Maybe[int](m).IsNothing()
+}
+
+// JustOr returns a valid I holding a default value if the I holds nothing, or
+// otherwise returns the I unchanged.  Unlike OrElse, it leaves other errors in
+// place.
+func (m I) JustOr(x int) I {
+	return I(Maybe[int](m).JustOr(x))
+}
+
+// OrElse returns the I if it is valid, or otherwise a valid I holding a default
+// value.
+func (m I) OrElse(x int) I {
+	return I(Maybe[int](m).OrElse(x))
+}
+
+// Recover returns the I if it is valid, or otherwise a valid I holding the
+// value returned by a function that takes the error.
+func (m I) Recover(f func(err error) int) I {
+	return I(Maybe[int](m).Recover(f))
+}
+
+// MapErr returns the I if it is valid, or otherwise an invalid I with the
+// error returned by a function that takes the original error, e.g. to add
+// context with fmt.Errorf and %w.  If the function returns nil, the original
+// error is kept.
+func (m I) MapErr(f func(err error) error) I {
+	return I(Maybe[int](m).MapErr(f))
+}
+
+// Catch is like Recover, but only for errors that match a target according
+// to errors.Is, and the function returns an I, so it may fail again.  Valid
+// values and other errors are returned unchanged.
+func (m I) Catch(target error, f func(err error) I) I {
+	return I(Maybe[int](m).Catch(target, toMaybe(f)))
+}
+
+// MarshalJSON implements json.Marshaler using the wire format described in
+// the package documentation.
+func (m I) MarshalJSON() ([]byte, error) {
+	return marshalJSON(m.Unbox())
+}
+
+// UnmarshalJSON implements json.Unmarshaler using the wire format described
+// in the package documentation.
+func (m *I) UnmarshalJSON(b []byte) error {
+	return unmarshalJSON(b, (*Maybe[int])(m), "I")
 }
 
 // String returns a string representation, mostly useful for debugging.
@@ -65,7 +151,69 @@ func (m I) ToStr(f func(x int) S) S {
 		return ErrS(m.err)
 	}
 
-	return f(m.just)
+	return try(f, m.just, ErrS)
+}
+
+// ToFloat applies a function that takes an int and returns an F.
+func (m I) ToFloat(f func(x int) F) F {
+	if m.err != nil {
+		return ErrF(m.err)
+	}
+
+	return try(f, m.just, ErrF)
+}
+
+// ToBool applies a function that takes an int and returns a B.
+func (m I) ToBool(f func(x int) B) B {
+	if m.err != nil {
+		return ErrB(m.err)
+	}
+
+	return try(f, m.just, ErrB)
+}
+
+// ToI64 converts a valid I to an I64.  If the I is invalid, ToI64 returns an
+// invalid I64.
+func (m I) ToI64() I64 {
+	if m.err != nil {
+		return ErrI64(m.err)
+	}
+
+	return I64(intToI64(m.just))
+}
+
+// ToU64 converts a valid I to a U64.  If the I is invalid or its value is out
+// of range for uint64, ToU64 returns an invalid U64.
+func (m I) ToU64() U64 {
+	if m.err != nil {
+		return ErrU64(m.err)
+	}
+
+	return U64(intToU64(m.just))
+}
+
+// ToBigI converts a valid I to a BigI.  If the I is invalid, ToBigI returns an
+// invalid BigI.
+func (m I) ToBigI() BigI {
+	if m.err != nil {
+		return ErrBigI(m.err)
+	}
+
+	return BigI(intToBigI(m.just))
+}
+
+// ToStrCtx is like ToStr, but the function also takes a context.  If the
+// context is done, ToStrCtx returns an invalid S with the context's error
+// instead of calling the function.
+func (m I) ToStrCtx(ctx context.Context, f func(ctx context.Context, x int) S) S {
+	if m.err != nil {
+		return ErrS(m.err)
+	}
+	if err := ctx.Err(); err != nil {
+		return ErrS(err)
+	}
+
+	return tryCtx(ctx, f, m.just, ErrS)
 }
 
 // Unbox returns the underlying int value or error.
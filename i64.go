@@ -0,0 +1,150 @@
+package maybe
+
+import "fmt"
+
+// I64 implements the Maybe monad for an int64.  An I64 is considered 'valid' or
+// 'invalid' depending on whether it contains an int64 or an error value.
+type I64 Maybe[int64]
+
+// NewI64 constructs an I64 from a given int64 or error. If e is not nil,
+// returns ErrI64(e), otherwise returns JustI64(x).
+func NewI64(x int64, e error) I64 {
+	if e != nil {
+		return ErrI64(e)
+	}
+	return JustI64(x)
+}
+
+// JustI64 constructs a valid I64 from a given int64.
+func JustI64(x int64) I64 {
+	return I64{just: x}
+}
+
+// ErrI64 constructs an invalid I64 from a given error.
+func ErrI64(e error) I64 {
+	return I64{err: e}
+}
+
+// NothingI64 constructs an invalid I64 that holds nothing, for a missing rather
+// than a failed value.  See ErrNothing.
+func NothingI64() I64 {
+	return I64{err: ErrNothing}
+}
+
+// IsErr returns true for an invalid I64.
+func (m I64) IsErr() bool {
+	return m.err != nil
+}
+
+// Bind applies a function that takes an int64 and returns an I64.
+func (m I64) Bind(f func(x int64) I64) I64 {
+	if m.err != nil {
+		return m
+	}
+
+	return try(f, m.just, ErrI64)
+}
+
+// Split applies a function that takes an int64 and returns an AoI64.
+func (m I64) Split(f func(x int64) AoI64) AoI64 {
+	if m.err != nil {
+		return ErrAoI64(m.err)
+	}
+
+	return try(f, m.just, ErrAoI64)
+}
+
+// ToI converts a valid I64 to an I.  If the I64 is invalid or its value is out
+// of range for int, ToI returns an invalid I.
+func (m I64) ToI() I {
+	if m.err != nil {
+		return ErrI(m.err)
+	}
+
+	return I(i64ToI(m.just))
+}
+
+// ToU64 converts a valid I64 to a U64.  If the I64 is invalid or its value is
+// out of range for uint64, ToU64 returns an invalid U64.
+func (m I64) ToU64() U64 {
+	if m.err != nil {
+		return ErrU64(m.err)
+	}
+
+	return U64(i64ToU64(m.just))
+}
+
+// ToBigI converts a valid I64 to a BigI.  If the I64 is invalid, ToBigI returns
+// an invalid BigI.
+func (m I64) ToBigI() BigI {
+	if m.err != nil {
+		return ErrBigI(m.err)
+	}
+
+	return BigI(i64ToBigI(m.just))
+}
+
+// IsNothing returns true for an I64 that holds nothing, i.e. one whose error is
+// ErrNothing itself.  Such an I64 is also invalid, so IsErr returns true.
+func (m I64) IsNothing() bool {
+	return Maybe[int64](m).IsNothing()
+}
+
+// JustOr returns a valid I64 holding a default value if the I64 holds nothing,
+// or otherwise returns the I64 unchanged.  Unlike OrElse, it leaves other
+// errors in place.
+func (m I64) JustOr(x int64) I64 {
+	return I64(Maybe[int64](m).JustOr(x))
+}
+
+// OrElse returns the I64 if it is valid, or otherwise a valid I64 holding a
+// default value.
+func (m I64) OrElse(x int64) I64 {
+	return I64(Maybe[int64](m).OrElse(x))
+}
+
+// Recover returns the I64 if it is valid, or otherwise a valid I64 holding the
+// value returned by a function that takes the error.
+func (m I64) Recover(f func(err error) int64) I64 {
+	return I64(Maybe[int64](m).Recover(f))
+}
+
+// MapErr returns the I64 if it is valid, or otherwise an invalid I64 with the
+// error returned by a function that takes the original error, e.g. to add
+// context with fmt.Errorf and %w.  If the function returns nil, the original
+// error is kept.
+func (m I64) MapErr(f func(err error) error) I64 {
+	return I64(Maybe[int64](m).MapErr(f))
+}
+
+// Catch is like Recover, but only for errors that match a target according
+// to errors.Is, and the function returns an I64, so it may fail again.  Valid
+// values and other errors are returned unchanged.
+func (m I64) Catch(target error, f func(err error) I64) I64 {
+	return I64(Maybe[int64](m).Catch(target, toMaybe(f)))
+}
+
+// MarshalJSON implements json.Marshaler using the wire format described in
+// the package documentation.
+func (m I64) MarshalJSON() ([]byte, error) {
+	return marshalJSON(m.Unbox())
+}
+
+// UnmarshalJSON implements json.Unmarshaler using the wire format described
+// in the package documentation.
+func (m *I64) UnmarshalJSON(b []byte) error {
+	return unmarshalJSON(b, (*Maybe[int64])(m), "I64")
+}
+
+// String returns a string representation, mostly useful for debugging.
+func (m I64) String() string {
+	if m.err != nil {
+		return fmt.Sprintf("Err %v", m.err)
+	}
+	return fmt.Sprintf("Just %v", m.just)
+}
+
+// Unbox returns the underlying int64 value or error.
+func (m I64) Unbox() (int64, error) {
+	return m.just, m.err
+}
@@ -0,0 +1,89 @@
+package maybe_test
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/xdg/maybe"
+	"github.com/xdg/testy"
+)
+
+func TestInt64(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	good := maybe.JustI64(math.MaxInt64)
+	bad := maybe.ErrI64(errors.New("bad int64"))
+
+	just, err := good.Unbox()
+	is.Equal(just, int64(math.MaxInt64))
+	is.Nil(err)
+	is.False(good.IsErr())
+
+	just, err = bad.Unbox()
+	is.Equal(just, int64(0))
+	is.Equal(err.Error(), "bad int64")
+	is.True(bad.IsErr())
+
+	is.Equal(maybe.NewI64(math.MaxInt64, nil), good)
+	is.True(maybe.NewI64(0, err).IsErr())
+
+	is.Equal(good.String(), "Just 9223372036854775807")
+	is.Equal(bad.String(), "Err bad int64")
+
+	neg := func(x int64) maybe.I64 { return maybe.JustI64(-x) }
+	just, err = maybe.JustI64(3).Bind(neg).Unbox()
+	is.Equal(just, int64(-3))
+	is.Nil(err)
+	is.True(bad.Bind(neg).IsErr())
+
+	both := func(x int64) maybe.AoI64 { return maybe.JustAoI64([]int64{x, -x}) }
+	xs, err := maybe.JustI64(3).Split(both).Unbox()
+	is.Equal(xs, []int64{3, -3})
+	is.Nil(err)
+	is.True(bad.Split(both).IsErr())
+}
+
+func TestInt64Conversions(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	just, err := maybe.JustS("-9223372036854775808").ToI64().Unbox()
+	is.Equal(just, int64(math.MinInt64))
+	is.Nil(err)
+
+	_, err = maybe.JustS("9223372036854775808").ToI64().Unbox()
+	is.True(errors.Is(err, strconv.ErrRange))
+	_, err = maybe.JustS("forty-two").ToI64().Unbox()
+	is.True(errors.Is(err, strconv.ErrSyntax))
+	is.True(maybe.ErrS(errors.New("bad string")).ToI64().IsErr())
+
+	just, err = maybe.JustI(-42).ToI64().Unbox()
+	is.Equal(just, int64(-42))
+	is.Nil(err)
+	is.True(maybe.ErrI(errors.New("bad int")).ToI64().IsErr())
+
+	i, err := maybe.JustI64(-42).ToI().Unbox()
+	is.Equal(i, -42)
+	is.Nil(err)
+	is.True(bad64().ToI().IsErr())
+
+	u, err := maybe.JustI64(42).ToU64().Unbox()
+	is.Equal(u, uint64(42))
+	is.Nil(err)
+	_, err = maybe.JustI64(-1).ToU64().Unbox()
+	is.True(errors.Is(err, strconv.ErrRange))
+	is.Equal(err.Error(), `strconv.ToU64: parsing "-1": value out of range`)
+	is.True(bad64().ToU64().IsErr())
+
+	b, err := maybe.JustI64(math.MinInt64).ToBigI().Unbox()
+	is.Equal(b.String(), "-9223372036854775808")
+	is.Nil(err)
+	is.True(bad64().ToBigI().IsErr())
+}
+
+func bad64() maybe.I64 {
+	return maybe.ErrI64(errors.New("bad int64"))
+}
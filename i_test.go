@@ -112,3 +112,42 @@ func TestIntToString(t *testing.T) {
 	is.True(got.IsErr())
 
 }
+
+func TestIntRecovery(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	bad := maybe.ErrI(errors.New("bad int"))
+	is.Equal(bad.OrElse(7), maybe.JustI(7))
+	is.Equal(maybe.JustI(1).OrElse(7), maybe.JustI(1))
+	is.Equal(bad.Recover(func(err error) int { return -1 }), maybe.JustI(-1))
+	is.True(bad.MapErr(func(err error) error { return nil }).IsErr())
+
+	// With panic recovery enabled, a panicking fallback is still an error
+	maybe.SetRecoverPanics(true)
+	defer maybe.SetRecoverPanics(false)
+	_, err := bad.Recover(func(err error) int { panic("no fallback") }).Unbox()
+	var pe *maybe.PanicError
+	is.True(errors.As(err, &pe))
+}
+
+func TestIntNothing(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	n := maybe.NothingI()
+	is.True(n.IsErr())
+	is.True(n.IsNothing())
+	is.Equal(n.JustOr(5), maybe.JustI(5))
+	is.Equal(maybe.JustI(2).JustOr(5), maybe.JustI(2))
+
+	bad := maybe.ErrI(errors.New("bad int"))
+	is.False(bad.IsNothing())
+	is.Equal(bad.JustOr(5), bad)
+	is.Equal(bad.OrElse(5), maybe.JustI(5))
+
+	double := func(x int) maybe.I { return maybe.JustI(x * 2) }
+	is.True(n.Bind(double).IsNothing())
+	is.True(n.Split(func(x int) maybe.AoI { return maybe.JustAoI([]int{x}) }).IsNothing())
+	is.True(n.ToStr(func(x int) maybe.S { return maybe.JustS(fmt.Sprint(x)) }).IsNothing())
+}
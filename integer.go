@@ -0,0 +1,113 @@
+package maybe
+
+import (
+	"math"
+	"math/big"
+	"strconv"
+)
+
+// The conversions between integer types below never wrap around.  A value
+// that doesn't fit the target type results in a *strconv.NumError wrapping
+// strconv.ErrRange, just like a string that doesn't fit when parsed, so both
+// can be detected with errors.Is(err, strconv.ErrRange).
+
+func rangeErr(fn string, num string) error {
+	return &strconv.NumError{Func: fn, Num: num, Err: strconv.ErrRange}
+}
+
+func parseI64(s string) Maybe[int64] {
+	return New(strconv.ParseInt(s, 10, 64))
+}
+
+func parseU64(s string) Maybe[uint64] {
+	return New(strconv.ParseUint(s, 10, 64))
+}
+
+func parseBigI(s string) Maybe[*big.Int] {
+	x, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		return Err[*big.Int](&strconv.NumError{Func: "ToBigI", Num: s, Err: strconv.ErrSyntax})
+	}
+	return Just(x)
+}
+
+func intToI64(x int) Maybe[int64] {
+	return Just(int64(x))
+}
+
+func intToU64(x int) Maybe[uint64] {
+	if x < 0 {
+		return Err[uint64](rangeErr("ToU64", strconv.Itoa(x)))
+	}
+	return Just(uint64(x))
+}
+
+func intToBigI(x int) Maybe[*big.Int] {
+	return Just(big.NewInt(int64(x)))
+}
+
+func i64ToI(x int64) Maybe[int] {
+	if x < math.MinInt || x > math.MaxInt {
+		return Err[int](rangeErr("ToI", strconv.FormatInt(x, 10)))
+	}
+	return Just(int(x))
+}
+
+func i64ToU64(x int64) Maybe[uint64] {
+	if x < 0 {
+		return Err[uint64](rangeErr("ToU64", strconv.FormatInt(x, 10)))
+	}
+	return Just(uint64(x))
+}
+
+func i64ToBigI(x int64) Maybe[*big.Int] {
+	return Just(big.NewInt(x))
+}
+
+func u64ToI(x uint64) Maybe[int] {
+	if x > math.MaxInt {
+		return Err[int](rangeErr("ToI", strconv.FormatUint(x, 10)))
+	}
+	return Just(int(x))
+}
+
+func u64ToI64(x uint64) Maybe[int64] {
+	if x > math.MaxInt64 {
+		return Err[int64](rangeErr("ToI64", strconv.FormatUint(x, 10)))
+	}
+	return Just(int64(x))
+}
+
+func u64ToBigI(x uint64) Maybe[*big.Int] {
+	return Just(new(big.Int).SetUint64(x))
+}
+
+func bigIToI(x *big.Int) Maybe[int] {
+	if x == nil {
+		return Err[int](&OpError{Op: "ToI", Type: "BigI", Err: ErrNilValue})
+	}
+	if !x.IsInt64() {
+		return Err[int](rangeErr("ToI", x.String()))
+	}
+	return i64ToI(x.Int64())
+}
+
+func bigIToI64(x *big.Int) Maybe[int64] {
+	if x == nil {
+		return Err[int64](&OpError{Op: "ToI64", Type: "BigI", Err: ErrNilValue})
+	}
+	if !x.IsInt64() {
+		return Err[int64](rangeErr("ToI64", x.String()))
+	}
+	return Just(x.Int64())
+}
+
+func bigIToU64(x *big.Int) Maybe[uint64] {
+	if x == nil {
+		return Err[uint64](&OpError{Op: "ToU64", Type: "BigI", Err: ErrNilValue})
+	}
+	if !x.IsUint64() {
+		return Err[uint64](rangeErr("ToU64", x.String()))
+	}
+	return Just(x.Uint64())
+}
@@ -0,0 +1,90 @@
+package maybe
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// Plain wraps a value of any type in this package except Seq so that it
+// marshals to JSON as the bare value, e.g. 42 rather than {"just": 42}, and
+// Nothing as null, which suits APIs whose clients don't know this package.
+// Errors are still marshaled as {"err": "..."}, and the elements of a Results
+// keep the wire format.  Use it per value, e.g.
+// `json.Marshal(maybe.Plain(port))`, or for a struct field of type
+// json.Marshaler.
+//
+// Plain output can't be unmarshaled back into these types, as it can't be
+// told apart from the wire format in general.
+func Plain[T any](m interface{ Unbox() (T, error) }) json.Marshaler {
+	x, err := m.Unbox()
+	return plain[T]{just: x, err: err}
+}
+
+type plain[T any] struct {
+	just T
+	err  error
+}
+
+func (p plain[T]) MarshalJSON() ([]byte, error) {
+	if p.err == ErrNothing {
+		return []byte("null"), nil
+	}
+	if p.err != nil {
+		msg := p.err.Error()
+		return json.Marshal(wireFormat{Err: &msg})
+	}
+	return json.Marshal(p.just)
+}
+
+type wireFormat struct {
+	Just    json.RawMessage `json:"just,omitempty"`
+	Err     *string         `json:"err,omitempty"`
+	Nothing bool            `json:"nothing,omitempty"`
+}
+
+func marshalJSON[T any](x T, err error) ([]byte, error) {
+	if err == ErrNothing {
+		return json.Marshal(wireFormat{Nothing: true})
+	}
+	if err != nil {
+		msg := err.Error()
+		return json.Marshal(wireFormat{Err: &msg})
+	}
+
+	b, err := json.Marshal(x)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(wireFormat{Just: b})
+}
+
+// unmarshalJSON decodes the wire format into *m.  Like encoding/json does for
+// its own types, it treats JSON null as a no-op and leaves *m unchanged.
+func unmarshalJSON[T any](b []byte, m *Maybe[T], typ string) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var w wireFormat
+	if err := json.Unmarshal(b, &w); err != nil {
+		return err
+	}
+
+	switch {
+	case w.Err != nil:
+		*m = Err[T](errors.New(*w.Err))
+	case w.Nothing:
+		*m = Nothing[T]()
+	case string(w.Just) == "null":
+		return &OpError{Op: "UnmarshalJSON", Type: typ, Err: ErrWireFormat}
+	case w.Just != nil:
+		var x T
+		if err := json.Unmarshal(w.Just, &x); err != nil {
+			return err
+		}
+		*m = Just(x)
+	default:
+		return &OpError{Op: "UnmarshalJSON", Type: typ, Err: ErrWireFormat}
+	}
+	return nil
+}
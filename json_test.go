@@ -0,0 +1,216 @@
+package maybe_test
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/xdg/maybe"
+	"github.com/xdg/testy"
+)
+
+func TestJSONRoundTrip(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	when := time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC)
+	cases := []struct {
+		in   interface{}
+		out  interface{}
+		want string
+	}{
+		{maybe.JustI(42), new(maybe.I), `{"just":42}`},
+		{maybe.JustS("a"), new(maybe.S), `{"just":"a"}`},
+		{maybe.JustF(1.5), new(maybe.F), `{"just":1.5}`},
+		{maybe.JustB(false), new(maybe.B), `{"just":false}`},
+		{maybe.JustX("a"), new(maybe.X), `{"just":"a"}`},
+		{maybe.JustR('x'), new(maybe.R), `{"just":120}`},
+		{maybe.JustT(when), new(maybe.T), `{"just":"2024-05-06T07:08:09Z"}`},
+		{maybe.JustD(time.Second), new(maybe.D), `{"just":1000000000}`},
+		{maybe.JustI64(-1), new(maybe.I64), `{"just":-1}`},
+		{maybe.JustU64(1), new(maybe.U64), `{"just":1}`},
+		{maybe.JustBigI(big.NewInt(7)), new(maybe.BigI), `{"just":7}`},
+		{maybe.JustAoI([]int{}), new(maybe.AoI), `{"just":[]}`},
+		{maybe.JustAoS([]string{"a", "b"}), new(maybe.AoS), `{"just":["a","b"]}`},
+		{maybe.JustAoAoI([][]int{{1}, {2, 3}}), new(maybe.AoAoI), `{"just":[[1],[2,3]]}`},
+		{maybe.JustAoAoS([][]string{{"a"}}), new(maybe.AoAoS), `{"just":[["a"]]}`},
+		{maybe.JustAoAoX([][]interface{}{{"a", true}}), new(maybe.AoAoX), `{"just":[["a",true]]}`},
+		{maybe.JustBytes([]byte("hi")), new(maybe.Bytes), `{"just":"aGk="}`},
+		{maybe.JustMoS(map[string]string{"k": "v"}), new(maybe.MoS), `{"just":{"k":"v"}}`},
+		{maybe.JustMoI(map[string]int{"k": 1}), new(maybe.MoI), `{"just":{"k":1}}`},
+		{maybe.Just(1), new(maybe.Maybe[int]), `{"just":1}`},
+		{maybe.JustSlice([]int{1}), new(maybe.Slice[int]), `{"just":[1]}`},
+		{maybe.JustGrid([][]int{{1}}), new(maybe.Grid[int]), `{"just":[[1]]}`},
+		{maybe.JustDict(map[string]int{"k": 1}), new(maybe.Dict[int]), `{"just":{"k":1}}`},
+		{maybe.NothingI(), new(maybe.I), `{"nothing":true}`},
+		{maybe.NothingAoS(), new(maybe.AoS), `{"nothing":true}`},
+		{maybe.ErrI(errors.New("bad")), new(maybe.I), `{"err":"bad"}`},
+		{maybe.ErrAoAoS(errors.New("bad")), new(maybe.AoAoS), `{"err":"bad"}`},
+	}
+
+	for _, c := range cases {
+		b, err := json.Marshal(c.in)
+		is.Nil(err)
+		is.Equal(string(b), c.want)
+		is.Nil(json.Unmarshal(b, c.out))
+		b, err = json.Marshal(c.out)
+		is.Nil(err)
+		is.Equal(string(b), c.want)
+	}
+
+	// Values read back are equal to the originals, but errors only by message
+	var i maybe.I
+	is.Nil(json.Unmarshal([]byte(`{"just":42}`), &i))
+	is.Equal(i, maybe.JustI(42))
+	is.Nil(json.Unmarshal([]byte(`{"err":"bad"}`), &i))
+	_, err := i.Unbox()
+	is.Equal(err.Error(), "bad")
+	is.Nil(json.Unmarshal([]byte(`{"nothing":true}`), &i))
+	is.True(i.IsNothing())
+}
+
+func TestJSONFields(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	type record struct {
+		Name  maybe.S
+		Ages  maybe.AoI
+		Attrs maybe.MoX
+	}
+	in := record{
+		Name:  maybe.JustS("Al"),
+		Ages:  maybe.ErrAoI(errors.New("no ages")),
+		Attrs: maybe.NothingMoX(),
+	}
+	want := `{"Name":{"just":"Al"},"Ages":{"err":"no ages"},"Attrs":{"nothing":true}}`
+
+	b, err := json.Marshal(in)
+	is.Nil(err)
+	is.Equal(string(b), want)
+
+	var out record
+	is.Nil(json.Unmarshal(b, &out))
+	is.Equal(out.Name, in.Name)
+	is.True(out.Ages.IsErr())
+	is.True(out.Attrs.IsNothing())
+
+	// Results hold a wire-format object for each element
+	r := maybe.JustAoS([]string{"1", "x"}).MapResults(func(s string) maybe.S {
+		if s == "x" {
+			return maybe.ErrS(errors.New("bad"))
+		}
+		return maybe.JustS(s)
+	})
+	b, err = json.Marshal(r)
+	is.Nil(err)
+	is.Equal(string(b), `{"just":[{"just":"1"},{"err":"bad"}]}`)
+	var r2 maybe.Results[string]
+	is.Nil(json.Unmarshal(b, &r2))
+	is.Equal(r2.Valid(), []string{"1"})
+	is.Equal(len(r2.Errors()), 1)
+}
+
+func TestJSONSpecialCases(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	// Zero values and nil X marshal as their errors
+	b, err := json.Marshal(maybe.AoI{})
+	is.Nil(err)
+	is.Equal(string(b), `{"err":"AoI.Unbox: zero value"}`)
+	b, err = json.Marshal(maybe.JustX(nil))
+	is.Nil(err)
+	is.Equal(string(b), `{"err":"X.Unbox: nil value"}`)
+
+	// Nothing wrapped by an element error is sent as an ordinary error
+	lookup := func(s string) maybe.S { return maybe.JustMoS(map[string]string{}).Get(s) }
+	b, err = json.Marshal(maybe.JustAoS([]string{"a"}).Map(lookup))
+	is.Nil(err)
+	is.Equal(string(b), `{"err":"element [0]: nothing"}`)
+
+	// Input that isn't in the wire format is rejected
+	var i maybe.I
+	err = json.Unmarshal([]byte(`{}`), &i)
+	is.True(errors.Is(err, maybe.ErrWireFormat))
+	is.Equal(err.Error(), "I.UnmarshalJSON: not in wire format")
+	is.NotNil(json.Unmarshal([]byte(`42`), &i))
+	is.NotNil(json.Unmarshal([]byte(`{"just":"x"}`), &i))
+	var aos maybe.AoS
+	is.True(errors.Is(json.Unmarshal([]byte(`{"value":[]}`), &aos), maybe.ErrWireFormat))
+
+	// A null "just" is rejected rather than read as a zero or nil value
+	i = maybe.JustI(7)
+	err = json.Unmarshal([]byte(`{"just":null}`), &i)
+	is.True(errors.Is(err, maybe.ErrWireFormat))
+	is.Equal(i, maybe.JustI(7))
+	var aoi maybe.AoI
+	err = json.Unmarshal([]byte(`{"just":null}`), &aoi)
+	is.Equal(err.Error(), "AoI.UnmarshalJSON: not in wire format")
+	var x maybe.X
+	is.True(errors.Is(json.Unmarshal([]byte(`{"just":null}`), &x), maybe.ErrWireFormat))
+
+	// Plain and the wire format agree on the named type of a zero value
+	b, err = json.Marshal(maybe.Plain(maybe.AoI{}))
+	is.Nil(err)
+	is.Equal(string(b), `{"err":"AoI.Unbox: zero value"}`)
+}
+
+func TestJSONPlain(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	cases := []struct {
+		in   json.Marshaler
+		want string
+	}{
+		{maybe.Plain(maybe.JustI(42)), `42`},
+		{maybe.Plain(maybe.JustAoS([]string{"a"})), `["a"]`},
+		{maybe.Plain(maybe.JustAoAoI([][]int{{1, 2}})), `[[1,2]]`},
+		{maybe.Plain(maybe.JustMoI(map[string]int{"k": 1})), `{"k":1}`},
+		{maybe.Plain(maybe.Just(1.5)), `1.5`},
+		{maybe.Plain(maybe.NothingS()), `null`},
+		{maybe.Plain(maybe.ErrI(errors.New("bad"))), `{"err":"bad"}`},
+		{maybe.Plain(maybe.JustX(nil)), `{"err":"X.Unbox: nil value"}`},
+	}
+	for _, c := range cases {
+		b, err := json.Marshal(c.in)
+		is.Nil(err)
+		is.Equal(string(b), c.want)
+	}
+
+	// Plain is per value, so other fields keep the wire format
+	type record struct {
+		Port json.Marshaler
+		Host maybe.S
+	}
+	b, err := json.Marshal(record{Port: maybe.Plain(maybe.JustI(80)), Host: maybe.JustS("a")})
+	is.Nil(err)
+	is.Equal(string(b), `{"Port":80,"Host":{"just":"a"}}`)
+}
+
+func TestJSONNull(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	// Null is a no-op, as for encoding/json's own types
+	var rec struct {
+		A maybe.I
+		B maybe.AoS
+		C maybe.X
+		D maybe.Results[int]
+	}
+	is.Nil(json.Unmarshal([]byte(`{"A":null,"B":null,"C":null,"D":null}`), &rec))
+	is.Equal(rec.A, maybe.I{})
+	is.Equal(rec.B, maybe.AoS{})
+
+	i := maybe.JustI(7)
+	is.Nil(json.Unmarshal([]byte(`null`), &i))
+	is.Equal(i, maybe.JustI(7))
+
+	var xs []maybe.I
+	is.Nil(json.Unmarshal([]byte(`[{"just":1},null]`), &xs))
+	is.Equal(xs, []maybe.I{maybe.JustI(1), {}})
+}
@@ -7,10 +7,54 @@
 //
 // This package only implements up to 2-D containers because those are common
 // when working with line-oriented data.  For example, a text file can be
-// interpreted as an array of an array of characters.
+// interpreted as an array of an array of characters, which `S.SplitRunes`
+// and `AoS.SplitRunes` produce as `AoR` and `AoAoR` respectively.
 //
 // Three constructors are provided for each type.  The `Just_` and `Err_`
 // constructors are for values and errors, respectively.  The `New_`
 // constructor can construct either type, and is intended for wrapping
 // functions that follow the pattern of returning a value and an error.
+//
+// The generic `Maybe[T]` type provides the same constructors and methods for
+// any type, plus the `Bind` and `Map` functions for operations that change
+// the boxed type.  Each named type is defined on top of it, so values can be
+// converted back and forth, e.g. `maybe.Maybe[int](i)` and `maybe.I(m)`.
+//
+// Likewise, `Slice[T]` and `Grid[T]` are generic 1-D and 2-D containers
+// underlying the `Ao_` and `AoAo_` types, with functions like `MapSlice` and
+// `MapCells` for conversions between element types.  `Dict[V]` plays the same
+// role for the `Mo_` types, short for "map of", which map strings to values.
+//
+// When a callback applied to the elements of a container fails, the error is
+// wrapped in an `ElementError` recording the element's position, so it can be
+// traced back to its source with `errors.As`.
+//
+// Failures originating in the package itself, such as unboxing a zero-value
+// container, are reported as an `OpError` wrapping one of the sentinel errors
+// `ErrZeroValue`, `ErrNotSlice` or `ErrNilValue`, for use with `errors.Is`.
+//
+// A panic in a callback crashes the program as usual, unless
+// `SetRecoverPanics(true)` has been called, in which case it becomes an error
+// wrapping a `PanicError` with the recovered value and stack trace.
+//
+// A value can also be missing rather than failed: the `Nothing_` constructors,
+// `FromPtr` with a nil pointer, `FromOK` with false and `Get` on a `Mo_` type
+// with an absent key all produce a container holding `ErrNothing`.  Nothing
+// follows the same rules as an error: `IsErr` is true, so Bind, Map, Join and
+// the rest pass it through without calling their functions, and a container
+// whose element is Nothing becomes invalid with an ordinary element error
+// wrapping it.  `IsNothing` is true only when the error is `ErrNothing` itself,
+// and `JustOr` supplies a default for that case alone, so a missing element
+// never hides a failed one.  A nil X is still an error, not Nothing.
+//
+// Every type except `Seq` can be marshaled to and from JSON as an object with
+// one of three keys: `{"just": 42}` for a valid value, `{"err": "..."}` for an
+// error and `{"nothing": true}` for Nothing.  The value of "just" is the boxed
+// value as `encoding/json` would marshal it, e.g. an array for an `AoI`.  Only
+// an error's message is sent, so an error read back won't match the original
+// with `errors.Is` or `errors.As`.  JSON null leaves a value unchanged, as it
+// does for `encoding/json`'s own types, but `{"just": null}` is rejected, so a
+// `Maybe` holding a nil pointer or interface can't be read back.  Wrapping a
+// value with `Plain` marshals it as the bare value, and Nothing as null, for
+// APIs, but that output can't be unmarshaled back.
 package maybe
@@ -0,0 +1,148 @@
+package maybe
+
+import "fmt"
+
+// MoI implements the Maybe monad for a map of strings to ints.  A MoI is
+// considered 'valid' or 'invalid' depending on whether it contains a map or
+// an error value.  A zero-value MoI is invalid and Unbox() will return an
+// error to that effect.
+type MoI Dict[int]
+
+// NewMoI constructs a MoI from a given map of strings to ints or error.  If
+// e is not nil, returns ErrMoI(e), otherwise returns JustMoI(x).
+func NewMoI(x map[string]int, e error) MoI {
+	if e != nil {
+		return ErrMoI(e)
+	}
+	return JustMoI(x)
+}
+
+// JustMoI constructs a valid MoI from a given map of strings to ints.
+func JustMoI(x map[string]int) MoI {
+	return MoI{just: x}
+}
+
+// ErrMoI constructs an invalid MoI from a given error.
+func ErrMoI(e error) MoI {
+	return MoI{err: e}
+}
+
+// NothingMoI constructs an invalid MoI that holds nothing, for a missing rather
+// than a failed value.  See ErrNothing.
+func NothingMoI() MoI {
+	return MoI{err: ErrNothing}
+}
+
+// IsErr returns true for an invalid MoI.
+func (m MoI) IsErr() bool {
+	return m.just == nil || m.err != nil
+}
+
+// Bind applies a function that takes a map of strings to ints and returns
+// a MoI.
+func (m MoI) Bind(f func(x map[string]int) MoI) MoI {
+	if m.IsErr() {
+		return m
+	}
+
+	return try(f, m.just, ErrMoI)
+}
+
+// MapValues applies a function to each value of a valid MoI and returns a
+// new MoI with the same keys.  If the MoI is invalid or if any function
+// returns an invalid I, MapValues returns an invalid MoI.
+func (m MoI) MapValues(f func(x int) I) MoI {
+	return MoI(MapDictValues(Dict[int](m).checkZero("MapValues", "MoI"), toMaybe(f)))
+}
+
+// Filter returns a new MoI with only the entries of a valid MoI for which a
+// function returns true.  If the MoI is invalid, Filter returns an invalid
+// MoI.
+func (m MoI) Filter(f func(k string, v int) bool) MoI {
+	return MoI(Dict[int](m).checkZero("Filter", "MoI").Filter(f))
+}
+
+// Keys returns the keys of a valid MoI in sorted order as an AoS.  If the
+// MoI is invalid, Keys returns an invalid AoS.
+func (m MoI) Keys() AoS {
+	return AoS(Dict[int](m).checkZero("Keys", "MoI").Keys())
+}
+
+// Get looks up a key in a valid MoI, resulting in a valid I holding its
+// value, or Nothing if the key is absent.  If the MoI is invalid, Get
+// returns an invalid I.
+func (m MoI) Get(k string) I {
+	return I(Dict[int](m).checkZero("Get", "MoI").Get(k))
+}
+
+// IsNothing returns true for an MoI that holds nothing, i.e. one whose error is
+// ErrNothing itself.  Such an MoI is also invalid, so IsErr returns true.  An
+// error that only wraps ErrNothing, such as an ElementError for a missing
+// element, is an ordinary error.
+func (m MoI) IsNothing() bool {
+	return Dict[int](m).IsNothing()
+}
+
+// JustOr returns a valid MoI holding a default value if the MoI holds nothing,
+// or otherwise returns the MoI unchanged.  Unlike OrElse, it leaves other
+// errors in place.  A nil default is replaced by an empty one, as for OrElse.
+func (m MoI) JustOr(x map[string]int) MoI {
+	return MoI(Dict[int](m).JustOr(x))
+}
+
+// OrElse returns the MoI if it is valid, or otherwise a valid MoI holding a
+// default value.  A nil default is replaced by an empty one, so the result is
+// always valid.
+func (m MoI) OrElse(x map[string]int) MoI {
+	return MoI(Dict[int](m).OrElse(x))
+}
+
+// Recover returns the MoI if it is valid, or otherwise a valid MoI holding the
+// value returned by a function that takes the error, with nil replaced by an
+// empty value as for OrElse.
+func (m MoI) Recover(f func(err error) map[string]int) MoI {
+	return MoI(Dict[int](m).checkZero("Unbox", "MoI").Recover(f))
+}
+
+// MapErr returns the MoI if it is valid, or otherwise an invalid MoI with the
+// error returned by a function that takes the original error, e.g. to add
+// context with fmt.Errorf and %w.  If the function returns nil, the original
+// error is kept.
+func (m MoI) MapErr(f func(err error) error) MoI {
+	return MoI(Dict[int](m).checkZero("Unbox", "MoI").MapErr(f))
+}
+
+// Catch is like Recover, but only for errors that match a target according
+// to errors.Is, and the function returns a MoI, so it may fail again.  Valid
+// values and other errors are returned unchanged.
+func (m MoI) Catch(target error, f func(err error) MoI) MoI {
+	return MoI(Dict[int](m).checkZero("Unbox", "MoI").Catch(target, toDict(f)))
+}
+
+// MarshalJSON implements json.Marshaler using the wire format described in
+// the package documentation.
+func (m MoI) MarshalJSON() ([]byte, error) {
+	return marshalJSON(m.Unbox())
+}
+
+// UnmarshalJSON implements json.Unmarshaler using the wire format described
+// in the package documentation.
+func (m *MoI) UnmarshalJSON(b []byte) error {
+	return unmarshalJSON(b, (*Maybe[map[string]int])(m), "MoI")
+}
+
+// String returns a string representation, mostly useful for debugging.
+func (m MoI) String() string {
+	if m.IsErr() {
+		return fmt.Sprintf("Err %v", m.err)
+	}
+	return fmt.Sprintf("Just %v", m.just)
+}
+
+// Unbox returns the underlying map or error.
+func (m MoI) Unbox() (map[string]int, error) {
+	if m.just == nil && m.err == nil {
+		return nil, &OpError{Op: "Unbox", Type: "MoI", Err: ErrZeroValue}
+	}
+	return m.just, m.err
+}
@@ -0,0 +1,79 @@
+package maybe_test
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/xdg/maybe"
+	"github.com/xdg/testy"
+)
+
+func TestMoI(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	input := map[string]int{"the": 3, "a": 1}
+	good := maybe.JustMoI(input)
+	bad := maybe.ErrMoI(errors.New("bad map"))
+	var just map[string]int
+	var err error
+
+	just, err = good.Unbox()
+	is.Equal(just, input)
+	is.Nil(err)
+	is.False(good.IsErr())
+
+	just, err = bad.Unbox()
+	is.Nil(just)
+	is.Equal(err.Error(), "bad map")
+	is.True(bad.IsErr())
+
+	is.Equal(maybe.NewMoI(input, nil), good)
+	is.True(maybe.NewMoI(nil, err).IsErr())
+	is.Equal(good.String(), "Just map[a:1 the:3]")
+
+	zero := maybe.MoI{}
+	is.True(zero.IsErr())
+	_, err = zero.Unbox()
+	is.NotNil(err)
+
+	keys, err := good.Keys().Unbox()
+	is.Equal(keys, []string{"a", "the"})
+	is.Nil(err)
+
+	notOne := func(x int) maybe.I {
+		if x == 1 {
+			return maybe.ErrI(errors.New("one"))
+		}
+		return maybe.JustI(x)
+	}
+	_, err = good.MapValues(notOne).Unbox()
+	is.Equal(err.Error(), `key "a": one`)
+
+	just, err = good.Filter(func(k string, v int) bool { return v > 1 }).MapValues(notOne).Unbox()
+	is.Equal(just, map[string]int{"the": 3})
+	is.Nil(err)
+	is.True(bad.Filter(func(k string, v int) bool { return true }).IsErr())
+}
+
+func TestAoAoSToMoI(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	atoi := func(s string) maybe.I { return maybe.NewI(strconv.Atoi(s)) }
+	fields := func(s string) maybe.AoS { return maybe.JustAoS(strings.Fields(s)) }
+	lines := maybe.JustAoS([]string{"apples 3", "pears 5"})
+
+	just, err := lines.Split(fields).ToMoI(atoi, true).Unbox()
+	is.Equal(just, map[string]int{"apples": 3, "pears": 5})
+	is.Nil(err)
+
+	_, err = maybe.JustAoS([]string{"apples 3", "pears many"}).Split(fields).ToMoI(atoi, true).Unbox()
+	var ee *maybe.ElementError
+	is.True(errors.As(err, &ee))
+	is.Equal(ee.Index, []int{1, 1})
+
+	is.True(maybe.ErrAoAoS(errors.New("bad strings")).ToMoI(atoi, false).IsErr())
+}
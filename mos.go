@@ -0,0 +1,148 @@
+package maybe
+
+import "fmt"
+
+// MoS implements the Maybe monad for a map of strings to strings.  A MoS is
+// considered 'valid' or 'invalid' depending on whether it contains a map or
+// an error value.  A zero-value MoS is invalid and Unbox() will return an
+// error to that effect.
+type MoS Dict[string]
+
+// NewMoS constructs a MoS from a given map of strings to strings or error.  If
+// e is not nil, returns ErrMoS(e), otherwise returns JustMoS(x).
+func NewMoS(x map[string]string, e error) MoS {
+	if e != nil {
+		return ErrMoS(e)
+	}
+	return JustMoS(x)
+}
+
+// JustMoS constructs a valid MoS from a given map of strings to strings.
+func JustMoS(x map[string]string) MoS {
+	return MoS{just: x}
+}
+
+// ErrMoS constructs an invalid MoS from a given error.
+func ErrMoS(e error) MoS {
+	return MoS{err: e}
+}
+
+// NothingMoS constructs an invalid MoS that holds nothing, for a missing rather
+// than a failed value.  See ErrNothing.
+func NothingMoS() MoS {
+	return MoS{err: ErrNothing}
+}
+
+// IsErr returns true for an invalid MoS.
+func (m MoS) IsErr() bool {
+	return m.just == nil || m.err != nil
+}
+
+// Bind applies a function that takes a map of strings to strings and returns
+// a MoS.
+func (m MoS) Bind(f func(x map[string]string) MoS) MoS {
+	if m.IsErr() {
+		return m
+	}
+
+	return try(f, m.just, ErrMoS)
+}
+
+// MapValues applies a function to each value of a valid MoS and returns a
+// new MoS with the same keys.  If the MoS is invalid or if any function
+// returns an invalid S, MapValues returns an invalid MoS.
+func (m MoS) MapValues(f func(x string) S) MoS {
+	return MoS(MapDictValues(Dict[string](m).checkZero("MapValues", "MoS"), toMaybe(f)))
+}
+
+// Filter returns a new MoS with only the entries of a valid MoS for which a
+// function returns true.  If the MoS is invalid, Filter returns an invalid
+// MoS.
+func (m MoS) Filter(f func(k string, v string) bool) MoS {
+	return MoS(Dict[string](m).checkZero("Filter", "MoS").Filter(f))
+}
+
+// Keys returns the keys of a valid MoS in sorted order as an AoS.  If the
+// MoS is invalid, Keys returns an invalid AoS.
+func (m MoS) Keys() AoS {
+	return AoS(Dict[string](m).checkZero("Keys", "MoS").Keys())
+}
+
+// Get looks up a key in a valid MoS, resulting in a valid S holding its
+// value, or Nothing if the key is absent.  If the MoS is invalid, Get
+// returns an invalid S.
+func (m MoS) Get(k string) S {
+	return S(Dict[string](m).checkZero("Get", "MoS").Get(k))
+}
+
+// IsNothing returns true for an MoS that holds nothing, i.e. one whose error is
+// ErrNothing itself.  Such an MoS is also invalid, so IsErr returns true.  An
+// error that only wraps ErrNothing, such as an ElementError for a missing
+// element, is an ordinary error.
+func (m MoS) IsNothing() bool {
+	return Dict[string](m).IsNothing()
+}
+
+// JustOr returns a valid MoS holding a default value if the MoS holds nothing,
+// or otherwise returns the MoS unchanged.  Unlike OrElse, it leaves other
+// errors in place.  A nil default is replaced by an empty one, as for OrElse.
+func (m MoS) JustOr(x map[string]string) MoS {
+	return MoS(Dict[string](m).JustOr(x))
+}
+
+// OrElse returns the MoS if it is valid, or otherwise a valid MoS holding a
+// default value.  A nil default is replaced by an empty one, so the result is
+// always valid.
+func (m MoS) OrElse(x map[string]string) MoS {
+	return MoS(Dict[string](m).OrElse(x))
+}
+
+// Recover returns the MoS if it is valid, or otherwise a valid MoS holding the
+// value returned by a function that takes the error, with nil replaced by an
+// empty value as for OrElse.
+func (m MoS) Recover(f func(err error) map[string]string) MoS {
+	return MoS(Dict[string](m).checkZero("Unbox", "MoS").Recover(f))
+}
+
+// MapErr returns the MoS if it is valid, or otherwise an invalid MoS with the
+// error returned by a function that takes the original error, e.g. to add
+// context with fmt.Errorf and %w.  If the function returns nil, the original
+// error is kept.
+func (m MoS) MapErr(f func(err error) error) MoS {
+	return MoS(Dict[string](m).checkZero("Unbox", "MoS").MapErr(f))
+}
+
+// Catch is like Recover, but only for errors that match a target according
+// to errors.Is, and the function returns a MoS, so it may fail again.  Valid
+// values and other errors are returned unchanged.
+func (m MoS) Catch(target error, f func(err error) MoS) MoS {
+	return MoS(Dict[string](m).checkZero("Unbox", "MoS").Catch(target, toDict(f)))
+}
+
+// MarshalJSON implements json.Marshaler using the wire format described in
+// the package documentation.
+func (m MoS) MarshalJSON() ([]byte, error) {
+	return marshalJSON(m.Unbox())
+}
+
+// UnmarshalJSON implements json.Unmarshaler using the wire format described
+// in the package documentation.
+func (m *MoS) UnmarshalJSON(b []byte) error {
+	return unmarshalJSON(b, (*Maybe[map[string]string])(m), "MoS")
+}
+
+// String returns a string representation, mostly useful for debugging.
+func (m MoS) String() string {
+	if m.IsErr() {
+		return fmt.Sprintf("Err %v", m.err)
+	}
+	return fmt.Sprintf("Just %v", m.just)
+}
+
+// Unbox returns the underlying map or error.
+func (m MoS) Unbox() (map[string]string, error) {
+	if m.just == nil && m.err == nil {
+		return nil, &OpError{Op: "Unbox", Type: "MoS", Err: ErrZeroValue}
+	}
+	return m.just, m.err
+}
@@ -0,0 +1,119 @@
+package maybe_test
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/xdg/maybe"
+	"github.com/xdg/testy"
+)
+
+func TestMoS(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	input := map[string]string{"host": "example.com", "port": "80"}
+	good := maybe.JustMoS(input)
+	bad := maybe.ErrMoS(errors.New("bad map"))
+	var just map[string]string
+	var err error
+
+	just, err = good.Unbox()
+	is.Equal(just, input)
+	is.Nil(err)
+	is.False(good.IsErr())
+
+	just, err = bad.Unbox()
+	is.Nil(just)
+	is.Equal(err.Error(), "bad map")
+	is.True(bad.IsErr())
+
+	is.Equal(maybe.NewMoS(input, nil), good)
+	is.True(maybe.NewMoS(nil, err).IsErr())
+
+	is.Equal(good.String(), "Just map[host:example.com port:80]")
+	is.Equal(bad.String(), "Err bad map")
+
+	zero := maybe.MoS{}
+	is.True(zero.IsErr())
+	_, err = zero.Unbox()
+	is.NotNil(err)
+
+	keys, err := good.Keys().Unbox()
+	is.Equal(keys, []string{"host", "port"})
+	is.Nil(err)
+	is.True(bad.Keys().IsErr())
+
+	upper := func(s string) maybe.S { return maybe.JustS(strings.ToUpper(s)) }
+	just, err = good.MapValues(upper).Unbox()
+	is.Equal(just, map[string]string{"host": "EXAMPLE.COM", "port": "80"})
+	is.Nil(err)
+	is.True(bad.MapValues(upper).IsErr())
+
+	just, err = good.Filter(func(k, v string) bool { return k != "port" }).Unbox()
+	is.Equal(just, map[string]string{"host": "example.com"})
+	is.Nil(err)
+
+	clear := func(x map[string]string) maybe.MoS { return maybe.JustMoS(map[string]string{}) }
+	just, err = good.Bind(clear).Unbox()
+	is.Equal(just, map[string]string{})
+	is.Nil(err)
+	is.True(bad.Bind(clear).IsErr())
+}
+
+func TestAoAoSToMoS(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	kv := func(s string) maybe.AoS { return maybe.JustAoS(strings.SplitN(s, "=", 2)) }
+	lines := maybe.JustAoS([]string{"a=1", "b=2=3", "a=4"})
+
+	just, err := lines.Split(kv).ToMoS(false).Unbox()
+	is.Equal(just, map[string]string{"a": "4", "b": "2=3"})
+	is.Nil(err)
+
+	_, err = lines.Split(kv).ToMoS(true).Unbox()
+	is.True(errors.Is(err, maybe.ErrDuplicateKey))
+	is.Equal(err.Error(), `element [2]: duplicate key "a"`)
+
+	_, err = maybe.JustAoS([]string{"a=1", "b"}).Split(kv).ToMoS(false).Unbox()
+	is.Equal(err.Error(), "element [1]: row has 1 fields, want 2")
+
+	is.True(maybe.ErrAoAoS(errors.New("bad strings")).ToMoS(false).IsErr())
+}
+
+func TestMoSRecovery(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	defaults := map[string]string{"port": "80"}
+	bad := maybe.JustAoAoS([][]string{{"port"}}).ToMoS(false)
+	is.Equal(bad.OrElse(defaults), maybe.JustMoS(defaults))
+	is.Equal(bad.OrElse(nil), maybe.JustMoS(map[string]string{}))
+
+	_, err := bad.MapErr(func(err error) error { return fmt.Errorf("config: %w", err) }).Unbox()
+	is.Equal(err.Error(), "config: element [0]: row has 1 fields, want 2")
+}
+
+func TestMoSGet(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	// A missing field is Nothing; a bad one is an error
+	record := maybe.JustMoS(map[string]string{"name": "Al", "age": "x"})
+	is.Equal(record.Get("name"), maybe.JustS("Al"))
+	is.True(record.Get("email").IsNothing())
+
+	age := func(s string) maybe.I { return maybe.NewI(strconv.Atoi(s)) }
+	is.False(record.Get("age").ToInt(age).IsNothing())
+	is.True(record.Get("age").ToInt(age).IsErr())
+	is.True(record.Get("height").ToInt(age).IsNothing())
+	is.Equal(record.Get("height").ToInt(age).JustOr(0), maybe.JustI(0))
+
+	is.True(maybe.NothingMoS().IsNothing())
+	is.True(maybe.JustMoI(map[string]int{}).Get("a").IsNothing())
+	is.Equal(maybe.JustMoI(map[string]int{"a": 1}).Get("a"), maybe.JustI(1))
+}
@@ -0,0 +1,149 @@
+package maybe
+
+import "fmt"
+
+// MoX implements the Maybe monad for a map of strings to empty interfaces.  A
+// MoX is considered 'valid' or 'invalid' depending on whether it contains a map
+// or an error value.  A zero-value MoX is invalid and Unbox() will return an
+// error to that effect.
+type MoX Dict[interface{}]
+
+// NewMoX constructs a MoX from a given map of strings to empty interfaces or
+// error.  If e is not nil, returns ErrMoX(e), otherwise returns JustMoX(x).
+func NewMoX(x map[string]interface{}, e error) MoX {
+	if e != nil {
+		return ErrMoX(e)
+	}
+	return JustMoX(x)
+}
+
+// JustMoX constructs a valid MoX from a given map of strings to empty
+// interfaces.
+func JustMoX(x map[string]interface{}) MoX {
+	return MoX{just: x}
+}
+
+// ErrMoX constructs an invalid MoX from a given error.
+func ErrMoX(e error) MoX {
+	return MoX{err: e}
+}
+
+// NothingMoX constructs an invalid MoX that holds nothing, for a missing rather
+// than a failed value.  See ErrNothing.
+func NothingMoX() MoX {
+	return MoX{err: ErrNothing}
+}
+
+// IsErr returns true for an invalid MoX.
+func (m MoX) IsErr() bool {
+	return m.just == nil || m.err != nil
+}
+
+// Bind applies a function that takes a map of strings to empty interfaces and
+// returns a MoX.
+func (m MoX) Bind(f func(x map[string]interface{}) MoX) MoX {
+	if m.IsErr() {
+		return m
+	}
+
+	return try(f, m.just, ErrMoX)
+}
+
+// MapValues applies a function to each value of a valid MoX and returns a
+// new MoX with the same keys.  If the MoX is invalid or if any function
+// returns an invalid X, MapValues returns an invalid MoX.
+func (m MoX) MapValues(f func(x interface{}) X) MoX {
+	return MoX(MapDictValues(Dict[interface{}](m).checkZero("MapValues", "MoX"), toMaybe(f)))
+}
+
+// Filter returns a new MoX with only the entries of a valid MoX for which a
+// function returns true.  If the MoX is invalid, Filter returns an invalid
+// MoX.
+func (m MoX) Filter(f func(k string, v interface{}) bool) MoX {
+	return MoX(Dict[interface{}](m).checkZero("Filter", "MoX").Filter(f))
+}
+
+// Keys returns the keys of a valid MoX in sorted order as an AoS.  If the
+// MoX is invalid, Keys returns an invalid AoS.
+func (m MoX) Keys() AoS {
+	return AoS(Dict[interface{}](m).checkZero("Keys", "MoX").Keys())
+}
+
+// Get looks up a key in a valid MoX, resulting in a valid X holding its
+// value, or Nothing if the key is absent.  If the MoX is invalid, Get
+// returns an invalid X.
+func (m MoX) Get(k string) X {
+	return X(Dict[interface{}](m).checkZero("Get", "MoX").Get(k))
+}
+
+// IsNothing returns true for an MoX that holds nothing, i.e. one whose error is
+// ErrNothing itself.  Such an MoX is also invalid, so IsErr returns true.  An
+// error that only wraps ErrNothing, such as an ElementError for a missing
+// element, is an ordinary error.
+func (m MoX) IsNothing() bool {
+	return Dict[interface{}](m).IsNothing()
+}
+
+// JustOr returns a valid MoX holding a default value if the MoX holds nothing,
+// or otherwise returns the MoX unchanged.  Unlike OrElse, it leaves other
+// errors in place.  A nil default is replaced by an empty one, as for OrElse.
+func (m MoX) JustOr(x map[string]interface{}) MoX {
+	return MoX(Dict[interface{}](m).JustOr(x))
+}
+
+// OrElse returns the MoX if it is valid, or otherwise a valid MoX holding a
+// default value.  A nil default is replaced by an empty one, so the result is
+// always valid.
+func (m MoX) OrElse(x map[string]interface{}) MoX {
+	return MoX(Dict[interface{}](m).OrElse(x))
+}
+
+// Recover returns the MoX if it is valid, or otherwise a valid MoX holding the
+// value returned by a function that takes the error, with nil replaced by an
+// empty value as for OrElse.
+func (m MoX) Recover(f func(err error) map[string]interface{}) MoX {
+	return MoX(Dict[interface{}](m).checkZero("Unbox", "MoX").Recover(f))
+}
+
+// MapErr returns the MoX if it is valid, or otherwise an invalid MoX with the
+// error returned by a function that takes the original error, e.g. to add
+// context with fmt.Errorf and %w.  If the function returns nil, the original
+// error is kept.
+func (m MoX) MapErr(f func(err error) error) MoX {
+	return MoX(Dict[interface{}](m).checkZero("Unbox", "MoX").MapErr(f))
+}
+
+// Catch is like Recover, but only for errors that match a target according
+// to errors.Is, and the function returns a MoX, so it may fail again.  Valid
+// values and other errors are returned unchanged.
+func (m MoX) Catch(target error, f func(err error) MoX) MoX {
+	return MoX(Dict[interface{}](m).checkZero("Unbox", "MoX").Catch(target, toDict(f)))
+}
+
+// MarshalJSON implements json.Marshaler using the wire format described in
+// the package documentation.
+func (m MoX) MarshalJSON() ([]byte, error) {
+	return marshalJSON(m.Unbox())
+}
+
+// UnmarshalJSON implements json.Unmarshaler using the wire format described
+// in the package documentation.
+func (m *MoX) UnmarshalJSON(b []byte) error {
+	return unmarshalJSON(b, (*Maybe[map[string]interface{}])(m), "MoX")
+}
+
+// String returns a string representation, mostly useful for debugging.
+func (m MoX) String() string {
+	if m.IsErr() {
+		return fmt.Sprintf("Err %v", m.err)
+	}
+	return fmt.Sprintf("Just %v", m.just)
+}
+
+// Unbox returns the underlying map or error.
+func (m MoX) Unbox() (map[string]interface{}, error) {
+	if m.just == nil && m.err == nil {
+		return nil, &OpError{Op: "Unbox", Type: "MoX", Err: ErrZeroValue}
+	}
+	return m.just, m.err
+}
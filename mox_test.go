@@ -0,0 +1,71 @@
+package maybe_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/xdg/maybe"
+	"github.com/xdg/testy"
+)
+
+func TestMoX(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	input := map[string]interface{}{"n": 1, "s": "one"}
+	good := maybe.JustMoX(input)
+	bad := maybe.ErrMoX(errors.New("bad map"))
+	var just map[string]interface{}
+	var err error
+
+	just, err = good.Unbox()
+	is.Equal(just, input)
+	is.Nil(err)
+	is.False(good.IsErr())
+
+	just, err = bad.Unbox()
+	is.Nil(just)
+	is.Equal(err.Error(), "bad map")
+	is.True(bad.IsErr())
+
+	is.Equal(maybe.NewMoX(input, nil), good)
+	is.True(maybe.NewMoX(nil, err).IsErr())
+	is.Equal(good.String(), "Just map[n:1 s:one]")
+
+	zero := maybe.MoX{}
+	is.True(zero.IsErr())
+	_, err = zero.Unbox()
+	is.NotNil(err)
+
+	keys, err := good.Keys().Unbox()
+	is.Equal(keys, []string{"n", "s"})
+	is.Nil(err)
+
+	wrap := func(x interface{}) maybe.X { return maybe.JustX([]interface{}{x}) }
+	just, err = good.MapValues(wrap).Unbox()
+	is.Equal(just, map[string]interface{}{"n": []interface{}{1}, "s": []interface{}{"one"}})
+	is.Nil(err)
+
+	isString := func(k string, v interface{}) bool { _, ok := v.(string); return ok }
+	just, err = good.Filter(isString).Unbox()
+	is.Equal(just, map[string]interface{}{"s": "one"})
+	is.Nil(err)
+}
+
+func TestAoAoXToMoX(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	rows := maybe.JustAoAoX([][]interface{}{{"a", 1}, {"b", true}})
+	just, err := rows.ToMoX(true).Unbox()
+	is.Equal(just, map[string]interface{}{"a": 1, "b": true})
+	is.Nil(err)
+
+	_, err = maybe.JustAoAoX([][]interface{}{{"a", 1}, {2, "b"}}).ToMoX(false).Unbox()
+	is.Equal(err.Error(), "element [1 0]: key is int, not string")
+
+	_, err = maybe.JustAoAoX([][]interface{}{{"a", 1}, {"a", 2}}).ToMoX(true).Unbox()
+	is.True(errors.Is(err, maybe.ErrDuplicateKey))
+
+	is.True(maybe.ErrAoAoX(errors.New("bad values")).ToMoX(false).IsErr())
+}
@@ -0,0 +1,63 @@
+package maybe
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+	"sync/atomic"
+)
+
+var recoverPanics atomic.Bool
+
+// SetRecoverPanics sets the package-wide policy for panics in functions
+// passed to Bind, Map, Split, Join and the other methods and functions that
+// take callbacks, and returns the previous policy.  By default, a panic
+// propagates as usual.  If recovery is enabled, a panic is instead converted
+// into an invalid result whose error is a *PanicError, which is wrapped in an
+// ElementError like any other failure of an element's callback.
+//
+// As the policy is global, it's meant to be set once by the application,
+// e.g. in main, rather than toggled by libraries.
+func SetRecoverPanics(enable bool) bool {
+	return recoverPanics.Swap(enable)
+}
+
+// PanicError holds the value recovered from a panic in a callback, along with
+// the stack trace of the goroutine at the time of the panic.
+type PanicError struct {
+	Value interface{}
+	Stack []byte
+}
+
+// Error returns the recovered value, without the stack trace.
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("panic: %v", e.Value)
+}
+
+// Unwrap returns the recovered value if it is an error, such as a
+// runtime.Error, or nil otherwise.
+func (e *PanicError) Unwrap() error {
+	if err, ok := e.Value.(error); ok {
+		return err
+	}
+	return nil
+}
+
+// try calls f(x).  If panic recovery is enabled and f panics, try instead
+// returns the result of fail with a *PanicError.
+func try[T, R any](f func(x T) R, x T, fail func(e error) R) (r R) {
+	if !recoverPanics.Load() {
+		return f(x)
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			r = fail(&PanicError{Value: v, Stack: debug.Stack()})
+		}
+	}()
+	return f(x)
+}
+
+// tryCtx is like try, but for functions that also take a context.
+func tryCtx[T, R any](ctx context.Context, f func(ctx context.Context, x T) R, x T, fail func(e error) R) R {
+	return try(func(x T) R { return f(ctx, x) }, x, fail)
+}
@@ -0,0 +1,102 @@
+package maybe_test
+
+import (
+	"errors"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/xdg/maybe"
+	"github.com/xdg/testy"
+)
+
+func TestPanicDefault(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	is.False(maybe.SetRecoverPanics(false))
+
+	var recovered interface{}
+	func() {
+		defer func() { recovered = recover() }()
+		maybe.JustX(nil).Bind(func(x interface{}) maybe.X { return maybe.JustX(x.(int)) })
+		maybe.JustX(42).Bind(func(x interface{}) maybe.X { return maybe.JustX(x.(string)) })
+	}()
+	is.NotNil(recovered)
+}
+
+func TestPanicRecovery(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	maybe.SetRecoverPanics(true)
+	defer maybe.SetRecoverPanics(false)
+
+	var pe *maybe.PanicError
+	var ee *maybe.ElementError
+	var re runtime.Error
+
+	// Scalar callbacks
+	_, err := maybe.JustX(42).Bind(func(x interface{}) maybe.X { return maybe.JustX(x.(string)) }).Unbox()
+	is.True(errors.As(err, &pe))
+	is.True(errors.As(err, &re))
+	is.True(strings.HasPrefix(err.Error(), "panic: interface conversion"))
+	is.True(len(pe.Stack) > 0)
+
+	// Element callbacks record the element's position
+	first := func(s string) maybe.S { return maybe.JustS(s[:1]) }
+	_, err = maybe.JustAoS([]string{"a", "", "c"}).Map(first).Unbox()
+	is.True(errors.As(err, &ee))
+	is.Equal(ee.Index, []int{1})
+	is.True(errors.As(err, &pe))
+	is.True(errors.As(err, &re))
+
+	split := func(x int) maybe.AoI { return maybe.JustAoI(make([]int, x)) }
+	_, err = maybe.JustAoI([]int{1, -1}).Split(split).Unbox()
+	is.True(errors.As(err, &ee))
+	is.Equal(ee.Index, []int{1})
+	is.True(errors.As(err, &pe))
+
+	// Non-error panic values
+	_, err = maybe.JustI(1).Bind(func(x int) maybe.I { panic("boom") }).Unbox()
+	is.True(errors.As(err, &pe))
+	is.Equal(pe.Value, "boom")
+	is.Equal(err.Error(), "panic: boom")
+	is.Nil(errors.Unwrap(err))
+
+	// Predicates
+	_, err = maybe.JustMoI(map[string]int{"a": 1, "b": 0}).Filter(func(k string, v int) bool { return 1/v > 0 }).Unbox()
+	is.True(errors.As(err, &pe))
+	is.Equal(err.Error(), `key "b": panic: runtime error: integer divide by zero`)
+	var ke *maybe.KeyError
+	is.True(errors.As(err, &ke))
+	is.Equal(ke.Key, "b")
+	_, err = maybe.JustSeq([]int{1, 0}).Filter(func(x int) bool { return 1/x > 0 }).Collect().Unbox()
+	is.True(errors.As(err, &ee))
+	is.Equal(ee.Index, []int{1})
+	is.True(errors.As(err, &pe))
+
+	// Concurrent callbacks
+	explode := func(s string) maybe.S {
+		if s == "x" {
+			panic("x")
+		}
+		return maybe.JustS(s)
+	}
+	_, err = maybe.JustAoS([]string{"a", "b", "x", "d"}).ParallelMap(2, explode).Unbox()
+	is.True(errors.As(err, &ee))
+	is.Equal(ee.Index, []int{2})
+	is.True(errors.As(err, &pe))
+
+	// Results keep going after a panic
+	r := maybe.JustAoS([]string{"a", "x", "d"}).MapResults(explode)
+	is.Equal(r.Valid(), []string{"a", "d"})
+	is.Equal(len(r.Errors()), 1)
+
+	// Successful callbacks are unaffected
+	xs, err := maybe.JustAoS([]string{"ab", "cd"}).Map(first).Unbox()
+	is.Equal(xs, []string{"a", "c"})
+	is.Nil(err)
+
+	is.True(maybe.SetRecoverPanics(true))
+}
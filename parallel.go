@@ -0,0 +1,148 @@
+package maybe
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// parallelDo calls f for each index from 0 to count-1 using at most n
+// goroutines, or GOMAXPROCS goroutines if n is less than 1.  Indices are
+// handed out in order and no new ones are started once a call has failed, so
+// the error returned is the one from the lowest failing index, just as for a
+// sequential loop.  It returns that index along with the error.
+//
+// A panic in f is recovered on the worker goroutine, which would otherwise
+// crash the program where no caller can recover it.  No new indices are
+// started after a panic, and once all workers are done, the first panic is
+// raised again on the calling goroutine, as it would be for a sequential
+// loop.
+func parallelDo(count, n int, f func(i int) error) (int, error) {
+	if n < 1 {
+		n = runtime.GOMAXPROCS(0)
+	}
+	if n > count {
+		n = count
+	}
+
+	var (
+		next     atomic.Int64
+		failed   atomic.Bool
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		errIdx   = -1
+		firstErr error
+		panicked bool
+		panicVal interface{}
+	)
+	for w := 0; w < n; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() {
+				if r := recover(); r != nil {
+					mu.Lock()
+					if !panicked {
+						panicked, panicVal = true, r
+					}
+					mu.Unlock()
+					failed.Store(true)
+				}
+			}()
+			for !failed.Load() {
+				i := int(next.Add(1) - 1)
+				if i >= count {
+					return
+				}
+				if err := f(i); err != nil {
+					mu.Lock()
+					if errIdx < 0 || i < errIdx {
+						errIdx, firstErr = i, err
+					}
+					mu.Unlock()
+					failed.Store(true)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	if panicked {
+		panic(panicVal)
+	}
+
+	return errIdx, firstErr
+}
+
+// ParallelMapSlice is like MapSlice, but runs the function on up to n
+// elements concurrently, or GOMAXPROCS elements if n is less than 1.  The
+// order of elements is preserved.  Once a function returns an invalid Maybe,
+// no further elements are started and ParallelMapSlice returns an invalid
+// Slice with the error of the first failing element.
+func ParallelMapSlice[T, U any](m Slice[T], n int, f func(x T) Maybe[U]) Slice[U] {
+	if m.IsErr() {
+		return ErrSlice[U](zeroErr(m.err, "ParallelMapSlice", "Slice"))
+	}
+
+	xs := make([]U, len(m.just))
+	i, err := parallelDo(len(m.just), n, func(i int) error {
+		x, err := try(f, m.just[i], Err[U]).Unbox()
+		xs[i] = x
+		return err
+	})
+	if err != nil {
+		return ErrSlice[U](elemErr(err, i))
+	}
+
+	return JustSlice(xs)
+}
+
+// ParallelMapGrid is like MapGrid, but runs the function on up to n rows
+// concurrently, with the same ordering and error semantics as
+// ParallelMapSlice.
+func ParallelMapGrid[T, U any](m Grid[T], n int, f func(x []T) Slice[U]) Grid[U] {
+	if m.IsErr() {
+		return ErrGrid[U](zeroErr(m.err, "ParallelMapGrid", "Grid"))
+	}
+
+	xss := make([][]U, len(m.just))
+	i, err := parallelDo(len(m.just), n, func(i int) error {
+		xs, err := try(f, m.just[i], ErrSlice[U]).Unbox()
+		xss[i] = xs
+		return err
+	})
+	if err != nil {
+		return ErrGrid[U](elemErr(err, i))
+	}
+
+	return JustGrid(xss)
+}
+
+// ParallelMapCells is like MapCells, but runs the function on up to n
+// elements concurrently, with the same ordering and error semantics as
+// ParallelMapSlice.
+func ParallelMapCells[T, U any](m Grid[T], n int, f func(x T) Maybe[U]) Grid[U] {
+	if m.IsErr() {
+		return ErrGrid[U](zeroErr(m.err, "ParallelMapCells", "Grid"))
+	}
+
+	xss := make([][]U, len(m.just))
+	var pos [][2]int
+	for i, xs := range m.just {
+		xss[i] = make([]U, len(xs))
+		for j := range xs {
+			pos = append(pos, [2]int{i, j})
+		}
+	}
+	k, err := parallelDo(len(pos), n, func(k int) error {
+		i, j := pos[k][0], pos[k][1]
+		x, err := try(f, m.just[i][j], Err[U]).Unbox()
+		xss[i][j] = x
+		return err
+	})
+	if err != nil {
+		return ErrGrid[U](elemErr(err, pos[k][0], pos[k][1]))
+	}
+
+	return JustGrid(xss)
+}
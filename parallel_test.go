@@ -0,0 +1,135 @@
+package maybe_test
+
+import (
+	"errors"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/xdg/maybe"
+	"github.com/xdg/testy"
+)
+
+func TestParallelMap(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	input := make([]string, 100)
+	want := make([]int, 100)
+	for i := range input {
+		input[i] = strconv.Itoa(i)
+		want[i] = i
+	}
+
+	// Track the number of callbacks running at once
+	var running, peak atomic.Int32
+	atoi := func(s string) maybe.I {
+		n := running.Add(1)
+		defer running.Add(-1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(time.Millisecond)
+		return maybe.NewI(strconv.Atoi(s))
+	}
+
+	got, err := maybe.JustAoS(input).ParallelToInt(4, atoi).Unbox()
+	is.Equal(got, want)
+	is.Nil(err)
+	is.True(peak.Load() <= 4)
+
+	// Invalid input
+	is.True(maybe.ErrAoS(errors.New("bad strings")).ParallelToInt(4, atoi).IsErr())
+
+	// Zero or negative limits use GOMAXPROCS
+	got, err = maybe.JustAoS(input).ParallelToInt(0, atoi).Unbox()
+	is.Equal(got, want)
+	is.Nil(err)
+}
+
+func TestParallelMapFirstError(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	var calls atomic.Int32
+	f := func(x int) maybe.I {
+		calls.Add(1)
+		if x == 1 || x == 3 {
+			return maybe.ErrI(errors.New("bad " + strconv.Itoa(x)))
+		}
+		return maybe.JustI(x)
+	}
+
+	// With a single worker, nothing after the failure is started
+	_, err := maybe.JustAoI([]int{0, 1, 2, 3, 4}).ParallelMap(1, f).Unbox()
+	is.Equal(err.Error(), "element [1]: bad 1")
+	is.Equal(calls.Load(), int32(2))
+
+	// With many workers, the lowest failing index still wins
+	for i := 0; i < 20; i++ {
+		_, err = maybe.JustAoI([]int{0, 1, 2, 3, 4}).ParallelMap(5, f).Unbox()
+		is.Equal(err.Error(), "element [1]: bad 1")
+	}
+}
+
+func TestParallelMapCells(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	atoi := func(s string) maybe.I { return maybe.NewI(strconv.Atoi(s)) }
+	input := maybe.JustAoAoS([][]string{{"1", "2"}, {}, {"3"}})
+
+	got, err := input.ParallelToInt(2, atoi).Unbox()
+	is.Equal(got, [][]int{{1, 2}, {}, {3}})
+	is.Nil(err)
+
+	var ee *maybe.ElementError
+	_, err = maybe.JustAoAoS([][]string{{"1"}, {"2", "x"}}).ParallelToInt(2, atoi).Unbox()
+	is.True(errors.As(err, &ee))
+	is.Equal(ee.Index, []int{1, 1})
+
+	// Row-wise
+	rev := func(xs []string) maybe.AoS {
+		out := make([]string, len(xs))
+		for i, v := range xs {
+			out[len(xs)-1-i] = v
+		}
+		return maybe.JustAoS(out)
+	}
+	rows, err := maybe.JustAoAoS([][]string{{"1", "2"}, {"3", "4"}}).ParallelMap(2, rev).Unbox()
+	is.Equal(rows, [][]string{{"2", "1"}, {"4", "3"}})
+	is.Nil(err)
+}
+
+func TestParallelMapPanic(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	var calls atomic.Int32
+	f := func(x int) maybe.I {
+		calls.Add(1)
+		if x == 1 {
+			panic("boom")
+		}
+		return maybe.JustI(x)
+	}
+
+	// Without recovery, the panic reaches the calling goroutine
+	got := func() (r interface{}) {
+		defer func() { r = recover() }()
+		maybe.JustAoI([]int{0, 1, 2, 3, 4}).ParallelMap(1, f)
+		return nil
+	}()
+	is.Equal(got, "boom")
+	is.Equal(calls.Load(), int32(2))
+
+	// With recovery, it becomes an error as for Map
+	defer maybe.SetRecoverPanics(maybe.SetRecoverPanics(true))
+	_, err := maybe.JustAoI([]int{0, 1, 2}).ParallelMap(3, f).Unbox()
+	var pe *maybe.PanicError
+	is.True(errors.As(err, &pe))
+}
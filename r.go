@@ -0,0 +1,121 @@
+package maybe
+
+import "fmt"
+
+// R implements the Maybe monad for a rune.  An R is considered 'valid' or
+// 'invalid' depending on whether it contains a rune or an error value.
+type R Maybe[rune]
+
+// NewR constructs an R from a given rune or error. If e is not nil, returns
+// ErrR(e), otherwise returns JustR(x).
+func NewR(x rune, e error) R {
+	if e != nil {
+		return ErrR(e)
+	}
+	return JustR(x)
+}
+
+// JustR constructs a valid R from a given rune.
+func JustR(x rune) R {
+	return R{just: x}
+}
+
+// ErrR constructs an invalid R from a given error.
+func ErrR(e error) R {
+	return R{err: e}
+}
+
+// NothingR constructs an invalid R that holds nothing, for a missing rather
+// than a failed value.  See ErrNothing.
+func NothingR() R {
+	return R{err: ErrNothing}
+}
+
+// IsErr returns true for an invalid R.
+func (m R) IsErr() bool {
+	return m.err != nil
+}
+
+// Bind applies a function that takes a rune and returns an R.
+func (m R) Bind(f func(x rune) R) R {
+	if m.err != nil {
+		return m
+	}
+
+	return try(f, m.just, ErrR)
+}
+
+// Split applies a function that takes a rune and returns an AoR.
+func (m R) Split(f func(x rune) AoR) AoR {
+	if m.err != nil {
+		return ErrAoR(m.err)
+	}
+
+	return try(f, m.just, ErrAoR)
+}
+
+// IsNothing returns true for an R that holds nothing, i.e. one whose error is
+// ErrNothing itself.  Such an R is also invalid, so IsErr returns true.
+func (m R) IsNothing() bool {
+	return Maybe[rune](m).IsNothing()
+}
+
+// JustOr returns a valid R holding a default value if the R holds nothing, or
+// otherwise returns the R unchanged.  Unlike OrElse, it leaves other errors in
+// place.
+func (m R) JustOr(x rune) R {
+	return R(Maybe[rune](m).JustOr(x))
+}
+
+// OrElse returns the R if it is valid, or otherwise a valid R holding a default
+// value.
+func (m R) OrElse(x rune) R {
+	return R(Maybe[rune](m).OrElse(x))
+}
+
+// Recover returns the R if it is valid, or otherwise a valid R holding the
+// value returned by a function that takes the error.
+func (m R) Recover(f func(err error) rune) R {
+	return R(Maybe[rune](m).Recover(f))
+}
+
+// MapErr returns the R if it is valid, or otherwise an invalid R with the
+// error returned by a function that takes the original error, e.g. to add
+// context with fmt.Errorf and %w.  If the function returns nil, the original
+// error is kept.
+func (m R) MapErr(f func(err error) error) R {
+	return R(Maybe[rune](m).MapErr(f))
+}
+
+// Catch is like Recover, but only for errors that match a target according
+// to errors.Is, and the function returns an R, so it may fail again.  Valid
+// values and other errors are returned unchanged.
+func (m R) Catch(target error, f func(err error) R) R {
+	return R(Maybe[rune](m).Catch(target, toMaybe(f)))
+}
+
+// MarshalJSON implements json.Marshaler using the wire format described in
+// the package documentation.
+func (m R) MarshalJSON() ([]byte, error) {
+	return marshalJSON(m.Unbox())
+}
+
+// UnmarshalJSON implements json.Unmarshaler using the wire format described
+// in the package documentation.
+func (m *R) UnmarshalJSON(b []byte) error {
+	return unmarshalJSON(b, (*Maybe[rune])(m), "R")
+}
+
+// String returns a string representation, mostly useful for debugging.
+// Runes are shown as quoted characters rather than numbers.
+func (m R) String() string {
+	if m.err != nil {
+		return fmt.Sprintf("Err %v", m.err)
+	}
+	return fmt.Sprintf("Just %q", m.just)
+}
+
+// Unbox returns the underlying rune value or error.
+func (m R) Unbox() (rune, error) {
+	return m.just, m.err
+}
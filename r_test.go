@@ -0,0 +1,53 @@
+package maybe_test
+
+import (
+	"errors"
+	"testing"
+	"unicode"
+
+	"github.com/xdg/maybe"
+	"github.com/xdg/testy"
+)
+
+func TestRune(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	var good, bad, got maybe.R
+	var just rune
+	var err error
+
+	good = maybe.JustR('a')
+	just, err = good.Unbox()
+	is.Equal(just, 'a')
+	is.Nil(err)
+	is.False(good.IsErr())
+
+	bad = maybe.ErrR(errors.New("bad rune"))
+	just, err = bad.Unbox()
+	is.Equal(just, rune(0))
+	is.NotNil(err)
+	is.Equal(err.Error(), "bad rune")
+	is.True(bad.IsErr())
+
+	got = maybe.NewR('a', nil)
+	is.Equal(got, good)
+
+	got = maybe.NewR(0, err)
+	is.True(got.IsErr())
+
+	is.Equal(good.String(), "Just 'a'")
+	is.Equal(bad.String(), "Err bad rune")
+
+	upper := func(x rune) maybe.R { return maybe.JustR(unicode.ToUpper(x)) }
+	just, err = good.Bind(upper).Unbox()
+	is.Equal(just, 'A')
+	is.Nil(err)
+	is.True(bad.Bind(upper).IsErr())
+
+	both := func(x rune) maybe.AoR { return maybe.JustAoR([]rune{x, unicode.ToUpper(x)}) }
+	xs, err := good.Split(both).Unbox()
+	is.Equal(xs, []rune("aA"))
+	is.Nil(err)
+	is.True(bad.Split(both).IsErr())
+}
@@ -0,0 +1,122 @@
+package maybe
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+)
+
+// LineOptions controls how the reader constructors split their input into
+// lines.  The zero value treats both "\n" and "\r\n" as line endings, ignores
+// a final line ending and uses bufio's default maximum line length.
+type LineOptions struct {
+	// KeepCR keeps a carriage return before a newline as part of the line
+	// rather than treating "\r\n" as a line ending.
+	KeepCR bool
+
+	// KeepTrailingEmpty reports an empty last line when the input ends with
+	// a line ending (or is empty), as strings.Split would.
+	KeepTrailingEmpty bool
+
+	// MaxLineSize is the maximum length of a line in bytes, not counting its
+	// line ending.  Longer lines make the result invalid with an error
+	// wrapping bufio.ErrTooLong.  If zero, bufio.MaxScanTokenSize is used.
+	MaxLineSize int
+}
+
+// splitFunc returns a bufio.SplitFunc for lines according to the options.
+// It tracks whether the last line read ended with a line ending, so a new
+// one is needed for each scanner.
+func (o LineOptions) splitFunc() bufio.SplitFunc {
+	ended := true
+	return func(data []byte, atEOF bool) (int, []byte, error) {
+		if i := bytes.IndexByte(data, '\n'); i >= 0 {
+			ended = true
+			return o.checkSize(i+1, o.dropCR(data[:i]))
+		}
+		if atEOF && len(data) > 0 {
+			ended = false
+			return o.checkSize(len(data), o.dropCR(data))
+		}
+		if atEOF && ended && o.KeepTrailingEmpty {
+			return 0, []byte{}, bufio.ErrFinalToken
+		}
+		return 0, nil, nil
+	}
+}
+
+// checkSize enforces MaxLineSize on a line without its line ending, which
+// the scanner's buffer has room for as well.
+func (o LineOptions) checkSize(advance int, line []byte) (int, []byte, error) {
+	if o.MaxLineSize > 0 && len(line) > o.MaxLineSize {
+		return 0, nil, bufio.ErrTooLong
+	}
+	return advance, line, nil
+}
+
+func (o LineOptions) dropCR(line []byte) []byte {
+	if !o.KeepCR && len(line) > 0 && line[len(line)-1] == '\r' {
+		return line[:len(line)-1]
+	}
+	return line
+}
+
+// SeqFromReader constructs a lazy sequence of the lines read from r, split
+// according to opts.  Lines are read as the sequence is iterated, so it can
+// only be iterated once.  If reading fails, the sequence ends with the
+// error, annotated with the number of the line being read.
+func SeqFromReader(r io.Reader, opts LineOptions) Seq[string] {
+	return NewSeq(func(yield func(string, error) bool) {
+		scanner := bufio.NewScanner(r)
+		if opts.MaxLineSize > 0 {
+			// Leave room for a "\r\n" line ending after the longest line.
+			scanner.Buffer(nil, opts.MaxLineSize+2)
+		}
+		scanner.Split(opts.splitFunc())
+
+		n := 0
+		for scanner.Scan() {
+			n++
+			if !yield(scanner.Text(), nil) {
+				return
+			}
+		}
+		if err := scanner.Err(); err != nil {
+			yield("", fmt.Errorf("reading line %d: %w", n+1, err))
+		}
+	})
+}
+
+// AoSFromReader constructs an AoS from the lines read from r, split
+// according to opts.  If reading fails, returns an invalid AoS.
+func AoSFromReader(r io.Reader, opts LineOptions) AoS {
+	return CollectAoS(SeqFromReader(r, opts))
+}
+
+// AoAoSFromReader constructs an AoAoS from the lines read from r, split
+// according to opts, with each line split into fields by the split function.
+// If reading fails or if any function returns an invalid AoS, returns an
+// invalid AoAoS.
+func AoAoSFromReader(r io.Reader, opts LineOptions, split func(s string) AoS) AoAoS {
+	return AoSFromReader(r, opts).Split(split)
+}
+
+// AoSFromFile is like AoSFromReader, but reads the file at the given path.
+// If the file can't be opened, returns an invalid AoS.
+func AoSFromFile(path string, opts LineOptions) AoS {
+	f, err := os.Open(path)
+	if err != nil {
+		return ErrAoS(err)
+	}
+	defer f.Close()
+
+	return AoSFromReader(f, opts)
+}
+
+// AoAoSFromFile is like AoAoSFromReader, but reads the file at the given
+// path.  If the file can't be opened, returns an invalid AoAoS.
+func AoAoSFromFile(path string, opts LineOptions, split func(s string) AoS) AoAoS {
+	return AoSFromFile(path, opts).Split(split)
+}
@@ -0,0 +1,118 @@
+package maybe_test
+
+import (
+	"bufio"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/xdg/maybe"
+	"github.com/xdg/testy"
+)
+
+func TestAoSFromReader(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	cases := []struct {
+		input string
+		opts  maybe.LineOptions
+		want  []string
+	}{
+		{"a\nb\n", maybe.LineOptions{}, []string{"a", "b"}},
+		{"a\nb", maybe.LineOptions{}, []string{"a", "b"}},
+		{"a\r\nb\r\n", maybe.LineOptions{}, []string{"a", "b"}},
+		{"a\r\nb\r\n", maybe.LineOptions{KeepCR: true}, []string{"a\r", "b\r"}},
+		{"a\n\nb\n", maybe.LineOptions{}, []string{"a", "", "b"}},
+		{"a\nb\n", maybe.LineOptions{KeepTrailingEmpty: true}, []string{"a", "b", ""}},
+		{"a\nb", maybe.LineOptions{KeepTrailingEmpty: true}, []string{"a", "b"}},
+		{"", maybe.LineOptions{}, []string{}},
+		{"", maybe.LineOptions{KeepTrailingEmpty: true}, []string{""}},
+	}
+
+	for _, c := range cases {
+		got, err := maybe.AoSFromReader(strings.NewReader(c.input), c.opts).Unbox()
+		is.Equal(got, c.want)
+		is.Nil(err)
+	}
+}
+
+func TestAoSFromReaderErrors(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	// Read failure
+	bad := errors.New("read failed")
+	_, err := maybe.AoSFromReader(iotest.ErrReader(bad), maybe.LineOptions{}).Unbox()
+	is.True(errors.Is(err, bad))
+
+	// Line too long
+	input := "short\n" + strings.Repeat("x", 100) + "\n"
+	_, err = maybe.AoSFromReader(strings.NewReader(input), maybe.LineOptions{MaxLineSize: 64}).Unbox()
+	is.True(errors.Is(err, bufio.ErrTooLong))
+	is.Equal(err.Error(), "reading line 2: bufio.Scanner: token too long")
+
+	// Lines of exactly MaxLineSize fit with any line ending; one more doesn't
+	opts := maybe.LineOptions{MaxLineSize: 4}
+	for _, input := range []string{"abcd", "abcd\n", "abcd\r\n", "ab\nabcd\r\nabcd"} {
+		_, err = maybe.AoSFromReader(strings.NewReader(input), opts).Unbox()
+		is.Nil(err)
+	}
+	for _, input := range []string{"abcde", "abcde\n", "abcde\r\n", "abcd\r\r\n"} {
+		_, err = maybe.AoSFromReader(strings.NewReader(input), opts).Unbox()
+		is.True(errors.Is(err, bufio.ErrTooLong))
+	}
+	_, err = maybe.AoSFromReader(strings.NewReader("abcd\r\n"), maybe.LineOptions{MaxLineSize: 4, KeepCR: true}).Unbox()
+	is.True(errors.Is(err, bufio.ErrTooLong))
+}
+
+func TestAoAoSFromReader(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	fields := func(s string) maybe.AoS { return maybe.JustAoS(strings.Fields(s)) }
+	input := "a b\r\nc d e\r\n"
+
+	got, err := maybe.AoAoSFromReader(strings.NewReader(input), maybe.LineOptions{}, fields).Unbox()
+	is.Equal(got, [][]string{{"a", "b"}, {"c", "d", "e"}})
+	is.Nil(err)
+
+	bad := errors.New("read failed")
+	is.True(maybe.AoAoSFromReader(iotest.ErrReader(bad), maybe.LineOptions{}, fields).IsErr())
+}
+
+func TestFromFile(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	path := filepath.Join(t.TempDir(), "input.txt")
+	is.Nil(os.WriteFile(path, []byte("a,b\nc,d\n"), 0o600))
+
+	lines, err := maybe.AoSFromFile(path, maybe.LineOptions{}).Unbox()
+	is.Equal(lines, []string{"a,b", "c,d"})
+	is.Nil(err)
+
+	comma := func(s string) maybe.AoS { return maybe.JustAoS(strings.Split(s, ",")) }
+	rows, err := maybe.AoAoSFromFile(path, maybe.LineOptions{}, comma).Unbox()
+	is.Equal(rows, [][]string{{"a", "b"}, {"c", "d"}})
+	is.Nil(err)
+
+	_, err = maybe.AoSFromFile(filepath.Join(t.TempDir(), "missing"), maybe.LineOptions{}).Unbox()
+	is.True(errors.Is(err, fs.ErrNotExist))
+	is.True(maybe.AoAoSFromFile("", maybe.LineOptions{}, comma).IsErr())
+}
+
+func TestSeqFromReader(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	// Only as much input as needed is read
+	r := strings.NewReader("1\n2\n3\n")
+	head := maybe.SeqFromReader(iotest.OneByteReader(r), maybe.LineOptions{}).Take(1)
+	is.Equal(maybe.CollectAoS(head), maybe.JustAoS([]string{"1"}))
+	is.True(r.Len() > 0)
+}
@@ -0,0 +1,190 @@
+package maybe
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Results holds the outcome of applying a function to each element of a
+// container, keeping a value or an error for every element rather than
+// stopping at the first failure.  A Results is itself considered 'invalid'
+// only if the container it was produced from was invalid.  A zero-value
+// Results is invalid and Unbox() will return an error to that effect.
+//
+// Compact and Collect convert a Results back into a Slice, which in turn
+// converts to the named types, e.g. `maybe.AoI(r.Compact())`.
+type Results[T any] struct {
+	just []Maybe[T]
+	err  error
+}
+
+// NewResults constructs a Results from a given slice of Maybe values or
+// error.  If e is not nil, returns ErrResults(e), otherwise returns
+// JustResults(x).
+func NewResults[T any](x []Maybe[T], e error) Results[T] {
+	if e != nil {
+		return ErrResults[T](e)
+	}
+	return JustResults(x)
+}
+
+// JustResults constructs a valid Results from a given slice of Maybe values.
+func JustResults[T any](x []Maybe[T]) Results[T] {
+	return Results[T]{just: x}
+}
+
+// ErrResults constructs an invalid Results from a given error.
+func ErrResults[T any](e error) Results[T] {
+	return Results[T]{err: e}
+}
+
+// IsErr returns true for an invalid Results.  Failures of individual
+// elements don't make a Results invalid; see Errors for those.
+func (m Results[T]) IsErr() bool {
+	return m.just == nil || m.err != nil
+}
+
+// Valid returns the values of the elements that succeeded, in order.
+func (m Results[T]) Valid() []T {
+	xs := make([]T, 0, len(m.just))
+	for _, v := range m.just {
+		if !v.IsErr() {
+			xs = append(xs, v.just)
+		}
+	}
+	return xs
+}
+
+// Errors returns the errors of the elements that failed, in order, each
+// wrapped in an ElementError with the element's index.  For an invalid
+// Results, it returns only the error of the Results itself.
+func (m Results[T]) Errors() []error {
+	if m.IsErr() {
+		_, err := m.Unbox()
+		return []error{err}
+	}
+
+	var errs []error
+	for i, v := range m.just {
+		if v.IsErr() {
+			errs = append(errs, elemErr(v.err, i))
+		}
+	}
+	return errs
+}
+
+// Compact returns a Slice of the values of the elements that succeeded,
+// discarding the failures.  If the Results is invalid, Compact returns an
+// invalid Slice.
+func (m Results[T]) Compact() Slice[T] {
+	if m.IsErr() {
+		return ErrSlice[T](zeroErr(m.err, "Compact", "Results"))
+	}
+
+	return JustSlice(m.Valid())
+}
+
+// Collect returns a Slice of all the values if every element succeeded.
+// Otherwise, it returns an invalid Slice whose error joins the errors from
+// all failing elements, as MapAll does.
+func (m Results[T]) Collect() Slice[T] {
+	if errs := m.Errors(); errs != nil {
+		return ErrSlice[T](errors.Join(errs...))
+	}
+
+	return JustSlice(m.Valid())
+}
+
+// OrElse returns the Results if it is valid, or otherwise a valid Results
+// holding a default value.  A nil default is replaced by an empty one, so the
+// result is always valid.
+func (m Results[T]) OrElse(x []Maybe[T]) Results[T] {
+	if m.IsErr() {
+		return JustResults(orEmpty(x))
+	}
+	return m
+}
+
+// Recover returns the Results if it is valid, or otherwise a valid Results
+// holding the value returned by a function that takes the error, with nil
+// replaced by an empty value as for OrElse.
+func (m Results[T]) Recover(f func(err error) []Maybe[T]) Results[T] {
+	if !m.IsErr() {
+		return m
+	}
+	_, err := m.Unbox()
+	return try(func(err error) Results[T] { return JustResults(orEmpty(f(err))) }, err, ErrResults[T])
+}
+
+// MapErr returns the Results if it is valid, or otherwise an invalid Results
+// with the error returned by a function that takes the original error, e.g. to
+// add context with fmt.Errorf and %w.  If the function returns nil, the
+// original error is kept.
+func (m Results[T]) MapErr(f func(err error) error) Results[T] {
+	if !m.IsErr() {
+		return m
+	}
+	_, err := m.Unbox()
+	if e := try(f, err, func(e error) error { return e }); e != nil {
+		return ErrResults[T](e)
+	}
+	return ErrResults[T](err)
+}
+
+// Catch is like Recover, but only for errors that match a target according to
+// errors.Is, and the function returns a Results, so it may fail again.  Valid
+// values and other errors are returned unchanged.
+func (m Results[T]) Catch(target error, f func(err error) Results[T]) Results[T] {
+	if !m.IsErr() {
+		return m
+	}
+	_, err := m.Unbox()
+	if !errors.Is(err, target) {
+		return m
+	}
+	return try(f, err, ErrResults[T])
+}
+
+// MarshalJSON implements json.Marshaler using the wire format described in
+// the package documentation.
+func (m Results[T]) MarshalJSON() ([]byte, error) {
+	return marshalJSON(m.Unbox())
+}
+
+// UnmarshalJSON implements json.Unmarshaler using the wire format described
+// in the package documentation.
+func (m *Results[T]) UnmarshalJSON(b []byte) error {
+	return unmarshalJSON(b, (*Maybe[[]Maybe[T]])(m), "Results")
+}
+
+// String returns a string representation, mostly useful for debugging.
+func (m Results[T]) String() string {
+	if m.IsErr() {
+		return fmt.Sprintf("Err %v", m.err)
+	}
+	return fmt.Sprintf("Just %v", m.just)
+}
+
+// Unbox returns the underlying slice of Maybe values or error.
+func (m Results[T]) Unbox() ([]Maybe[T], error) {
+	if m.just == nil && m.err == nil {
+		return nil, &OpError{Op: "Unbox", Type: "Results", Err: ErrZeroValue}
+	}
+	return m.just, m.err
+}
+
+// MapResults applies a function to each element of a valid Slice[T] and
+// returns the outcome for every element as a Results[U].  If the Slice is
+// invalid, MapResults returns an invalid Results.
+func MapResults[T, U any](m Slice[T], f func(x T) Maybe[U]) Results[U] {
+	if m.IsErr() {
+		return ErrResults[U](zeroErr(m.err, "MapResults", "Slice"))
+	}
+
+	xs := make([]Maybe[U], len(m.just))
+	for i, v := range m.just {
+		xs[i] = try(f, v, Err[U])
+	}
+
+	return JustResults(xs)
+}
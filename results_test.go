@@ -0,0 +1,82 @@
+package maybe_test
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/xdg/maybe"
+	"github.com/xdg/testy"
+)
+
+func TestResults(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	bad := errors.New("bad int")
+	input := []maybe.Maybe[int]{maybe.Just(23), maybe.Err[int](bad), maybe.Just(42)}
+	good := maybe.JustResults(input)
+	invalid := maybe.ErrResults[int](errors.New("bad results"))
+	var ee *maybe.ElementError
+
+	just, err := good.Unbox()
+	is.Equal(just, input)
+	is.Nil(err)
+	is.False(good.IsErr())
+	is.True(invalid.IsErr())
+	is.True(maybe.Results[int]{}.IsErr())
+	is.Equal(maybe.NewResults(input, nil), good)
+	is.True(maybe.NewResults(input, bad).IsErr())
+
+	is.Equal(good.String(), "Just [Just 23 Err bad int Just 42]")
+	is.Equal(invalid.String(), "Err bad results")
+
+	// Valid and Errors split the outcomes
+	is.Equal(good.Valid(), []int{23, 42})
+	errs := good.Errors()
+	is.Equal(len(errs), 1)
+	is.True(errors.As(errs[0], &ee))
+	is.Equal(ee.Index, []int{1})
+	is.True(errors.Is(errs[0], bad))
+	is.Equal(invalid.Errors()[0].Error(), "bad results")
+
+	// Compact keeps only valid values
+	xs, err := maybe.AoI(good.Compact()).Unbox()
+	is.Equal(xs, []int{23, 42})
+	is.Nil(err)
+	is.True(invalid.Compact().IsErr())
+
+	// Collect requires every element to succeed
+	_, err = good.Collect().Unbox()
+	is.Equal(err.Error(), "element [1]: bad int")
+	xs, err = maybe.JustResults([]maybe.Maybe[int]{maybe.Just(1)}).Collect().Unbox()
+	is.Equal(xs, []int{1})
+	is.Nil(err)
+	is.True(invalid.Collect().IsErr())
+}
+
+func TestAoSToIntResults(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	atoi := func(s string) maybe.I { return maybe.NewI(strconv.Atoi(s)) }
+	lines := maybe.JustAoS([]string{"23", "x", "42", "y"})
+
+	got := lines.ToIntResults(atoi)
+	is.Equal(got.Valid(), []int{23, 42})
+	errs := got.Errors()
+	is.Equal(len(errs), 2)
+	is.Equal(errs[1].Error(), "element [3]: strconv.Atoi: parsing \"y\": invalid syntax")
+
+	is.True(maybe.ErrAoS(errors.New("bad strings")).ToIntResults(atoi).IsErr())
+
+	// Map siblings keep every outcome too
+	strs := lines.MapResults(func(s string) maybe.S {
+		if s == "x" {
+			return maybe.ErrS(errors.New("no x"))
+		}
+		return maybe.JustS(s + "!")
+	})
+	is.Equal(strs.Valid(), []string{"23!", "42!", "y!"})
+	is.Equal(maybe.AoS(strs.Compact()), maybe.JustAoS([]string{"23!", "42!", "y!"}))
+}
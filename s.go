@@ -1,13 +1,14 @@
 package maybe
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+	"time"
+)
 
 // S implements the Maybe monad for a string.  An S is considered 'valid' or
 // 'invalid' depending on whether it contains a string or an error value.
-type S struct {
-	just string
-	err  error
-}
+type S Maybe[string]
 
 // NewS constructs an S from a given string or error. If e is not nil, returns
 // ErrS(e), otherwise returns JustS(s)
@@ -28,6 +29,12 @@ func ErrS(e error) S {
 	return S{err: e}
 }
 
+// NothingS constructs an invalid S that holds nothing, for a missing rather
+// than a failed value.  See ErrNothing.
+func NothingS() S {
+	return S{err: ErrNothing}
+}
+
 // IsErr returns true for an invalid S.
 func (m S) IsErr() bool {
 	return m.err != nil
@@ -39,7 +46,21 @@ func (m S) Bind(f func(s string) S) S {
 		return m
 	}
 
-	return f(m.just)
+	return try(f, m.just, ErrS)
+}
+
+// BindCtx is like Bind, but the function also takes a context.  If the
+// context is done, BindCtx returns an invalid S with the context's error
+// instead of calling the function.
+func (m S) BindCtx(ctx context.Context, f func(ctx context.Context, s string) S) S {
+	if m.err != nil {
+		return m
+	}
+	if err := ctx.Err(); err != nil {
+		return ErrS(err)
+	}
+
+	return tryCtx(ctx, f, m.just, ErrS)
 }
 
 // Split applies a function that takes a string and returns an AoS.
@@ -48,7 +69,21 @@ func (m S) Split(f func(s string) AoS) AoS {
 		return ErrAoS(m.err)
 	}
 
-	return f(m.just)
+	return try(f, m.just, ErrAoS)
+}
+
+// SplitCtx is like Split, but the function also takes a context.  If the
+// context is done, SplitCtx returns an invalid AoS with the context's error
+// instead of calling the function.
+func (m S) SplitCtx(ctx context.Context, f func(ctx context.Context, s string) AoS) AoS {
+	if m.err != nil {
+		return ErrAoS(m.err)
+	}
+	if err := ctx.Err(); err != nil {
+		return ErrAoS(err)
+	}
+
+	return tryCtx(ctx, f, m.just, ErrAoS)
 }
 
 // ToInt applies a function that takes a string and returns an I.
@@ -57,7 +92,164 @@ func (m S) ToInt(f func(s string) I) I {
 		return ErrI(m.err)
 	}
 
-	return f(m.just)
+	return try(f, m.just, ErrI)
+}
+
+// ToFloat applies a function that takes a string and returns an F.
+func (m S) ToFloat(f func(s string) F) F {
+	if m.err != nil {
+		return ErrF(m.err)
+	}
+
+	return try(f, m.just, ErrF)
+}
+
+// ToBool applies a function that takes a string and returns a B.
+func (m S) ToBool(f func(s string) B) B {
+	if m.err != nil {
+		return ErrB(m.err)
+	}
+
+	return try(f, m.just, ErrB)
+}
+
+// SplitRunes splits a valid S into its Unicode code points, resulting in an
+// AoR.  Invalid UTF-8 sequences become utf8.RuneError.  If the S is invalid,
+// SplitRunes returns an invalid AoR.
+func (m S) SplitRunes() AoR {
+	if m.err != nil {
+		return ErrAoR(m.err)
+	}
+
+	return JustAoR([]rune(m.just))
+}
+
+// ToBytes converts a valid S to Bytes.  If the S is invalid, ToBytes returns
+// an invalid Bytes.
+func (m S) ToBytes() Bytes {
+	if m.err != nil {
+		return ErrBytes(m.err)
+	}
+
+	return JustBytes([]byte(m.just))
+}
+
+// ToTime parses a valid S as a time according to a layout, as time.Parse
+// does, resulting in a T.  If the S is invalid or can't be parsed, ToTime
+// returns an invalid T.
+func (m S) ToTime(layout string) T {
+	if m.err != nil {
+		return ErrT(m.err)
+	}
+
+	return NewT(time.Parse(layout, m.just))
+}
+
+// ToDuration parses a valid S as a duration, as time.ParseDuration does,
+// resulting in a D.  If the S is invalid or can't be parsed, ToDuration
+// returns an invalid D.
+func (m S) ToDuration() D {
+	if m.err != nil {
+		return ErrD(m.err)
+	}
+
+	return NewD(time.ParseDuration(m.just))
+}
+
+// ToI64 parses a valid S as a base-10 int64.  If the S is invalid or its value
+// can't be parsed or is out of range for int64, ToI64 returns an invalid I64.
+func (m S) ToI64() I64 {
+	if m.err != nil {
+		return ErrI64(m.err)
+	}
+
+	return I64(parseI64(m.just))
+}
+
+// ToU64 parses a valid S as a base-10 uint64.  If the S is invalid or its value
+// can't be parsed or is out of range for uint64, ToU64 returns an invalid U64.
+func (m S) ToU64() U64 {
+	if m.err != nil {
+		return ErrU64(m.err)
+	}
+
+	return U64(parseU64(m.just))
+}
+
+// ToBigI parses a valid S as a base-10 *big.Int of arbitrary size.  If the S
+// is invalid or its value can't be parsed, ToBigI returns an invalid BigI.
+func (m S) ToBigI() BigI {
+	if m.err != nil {
+		return ErrBigI(m.err)
+	}
+
+	return BigI(parseBigI(m.just))
+}
+
+// ToIntCtx is like ToInt, but the function also takes a context.  If the
+// context is done, ToIntCtx returns an invalid I with the context's error
+// instead of calling the function.
+func (m S) ToIntCtx(ctx context.Context, f func(ctx context.Context, s string) I) I {
+	if m.err != nil {
+		return ErrI(m.err)
+	}
+	if err := ctx.Err(); err != nil {
+		return ErrI(err)
+	}
+
+	return tryCtx(ctx, f, m.just, ErrI)
+}
+
+// IsNothing returns true for an S that holds nothing, i.e. one whose error is
+// ErrNothing itself.  Such an S is also invalid, so IsErr returns true.
+func (m S) IsNothing() bool {
+	return Maybe[string](m).IsNothing()
+}
+
+// JustOr returns a valid S holding a default value if the S holds nothing, or
+// otherwise returns the S unchanged.  Unlike OrElse, it leaves other errors in
+// place.
+func (m S) JustOr(x string) S {
+	return S(Maybe[string](m).JustOr(x))
+}
+
+// OrElse returns the S if it is valid, or otherwise a valid S holding a default
+// value.
+func (m S) OrElse(x string) S {
+	return S(Maybe[string](m).OrElse(x))
+}
+
+// Recover returns the S if it is valid, or otherwise a valid S holding the
+// value returned by a function that takes the error.
+func (m S) Recover(f func(err error) string) S {
+	return S(Maybe[string](m).Recover(f))
+}
+
+// MapErr returns the S if it is valid, or otherwise an invalid S with the
+// error returned by a function that takes the original error, e.g. to add
+// context with fmt.Errorf and %w.  If the function returns nil, the original
+// error is kept.
+func (m S) MapErr(f func(err error) error) S {
+	return S(Maybe[string](m).MapErr(f))
+}
+
+// Catch is like Recover, but only for errors that match a target according
+// to errors.Is, and the function returns an S, so it may fail again.  Valid
+// values and other errors are returned unchanged.
+func (m S) Catch(target error, f func(err error) S) S {
+	return S(Maybe[string](m).Catch(target, toMaybe(f)))
+}
+
+// MarshalJSON implements json.Marshaler using the wire format described in
+// the package documentation.
+func (m S) MarshalJSON() ([]byte, error) {
+	return marshalJSON(m.Unbox())
+}
+
+// UnmarshalJSON implements json.Unmarshaler using the wire format described
+// in the package documentation.
+func (m *S) UnmarshalJSON(b []byte) error {
+	return unmarshalJSON(b, (*Maybe[string])(m), "S")
 }
 
 // String returns a string representation, mostly useful for debugging.
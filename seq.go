@@ -0,0 +1,179 @@
+package maybe
+
+import "iter"
+
+// Seq implements a lazy sequence of values of type T, built on an
+// iter.Seq2[T, error].  Unlike the other types in this package, nothing is
+// evaluated until the sequence is iterated, e.g. by All or Collect, so a Seq
+// can process data too large to hold in memory.  The first error in the
+// sequence terminates it.  A zero-value Seq yields only an error to that
+// effect.
+type Seq[T any] struct {
+	seq iter.Seq2[T, error]
+}
+
+// NewSeq constructs a Seq from a given iterator of values and errors.  The
+// sequence ends after the first non-nil error.
+func NewSeq[T any](seq iter.Seq2[T, error]) Seq[T] {
+	return Seq[T]{seq: seq}
+}
+
+// JustSeq constructs a Seq that yields the elements of a given slice.
+func JustSeq[T any](xs []T) Seq[T] {
+	return Seq[T]{seq: func(yield func(T, error) bool) {
+		for _, x := range xs {
+			if !yield(x, nil) {
+				return
+			}
+		}
+	}}
+}
+
+// ErrSeq constructs a Seq that yields only the given error.
+func ErrSeq[T any](e error) Seq[T] {
+	return Seq[T]{seq: func(yield func(T, error) bool) {
+		var zero T
+		yield(zero, e)
+	}}
+}
+
+// All returns an iterator over the values of the sequence.  If the sequence
+// fails, the last pair yielded carries the error and a zero value.
+func (m Seq[T]) All() iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		if m.seq == nil {
+			yield(zero, &OpError{Op: "All", Type: "Seq", Err: ErrZeroValue})
+			return
+		}
+		for x, err := range m.seq {
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+			if !yield(x, nil) {
+				return
+			}
+		}
+	}
+}
+
+// Map lazily applies a function to each element of the sequence.  If any
+// function returns an invalid Maybe, the sequence ends with that error.
+func (m Seq[T]) Map(f func(x T) Maybe[T]) Seq[T] {
+	return MapSeq(m, f)
+}
+
+// Filter lazily drops the elements of the sequence for which a function
+// returns false.  If the function panics and panic recovery is enabled, the
+// sequence ends with the error, as for Map.
+func (m Seq[T]) Filter(f func(x T) bool) Seq[T] {
+	keep := func(x T) Maybe[bool] { return Just(f(x)) }
+	return Seq[T]{seq: func(yield func(T, error) bool) {
+		var zero T
+		i := 0
+		for x, err := range m.All() {
+			if err == nil {
+				ok, err := try(keep, x, Err[bool]).Unbox()
+				if err != nil {
+					yield(zero, elemErr(err, i))
+					return
+				}
+				i++
+				if !ok {
+					continue
+				}
+			}
+			if !yield(x, err) {
+				return
+			}
+		}
+	}}
+}
+
+// Take limits the sequence to at most its first n elements.
+func (m Seq[T]) Take(n int) Seq[T] {
+	return Seq[T]{seq: func(yield func(T, error) bool) {
+		if n <= 0 {
+			return
+		}
+		i := 0
+		for x, err := range m.All() {
+			if !yield(x, err) {
+				return
+			}
+			i++
+			if i >= n {
+				return
+			}
+		}
+	}}
+}
+
+// Collect evaluates the sequence and returns its elements as a Slice.  If
+// the sequence fails, Collect returns an invalid Slice.
+func (m Seq[T]) Collect() Slice[T] {
+	xs := make([]T, 0)
+	for x, err := range m.All() {
+		if err != nil {
+			return ErrSlice[T](err)
+		}
+		xs = append(xs, x)
+	}
+
+	return JustSlice(xs)
+}
+
+// MapSeq lazily applies a function to each element of a Seq[T], resulting
+// in a Seq[U].  If any function returns an invalid Maybe, the sequence ends
+// with that error, wrapped in an ElementError with the element's position in
+// the sequence.
+func MapSeq[T, U any](m Seq[T], f func(x T) Maybe[U]) Seq[U] {
+	return Seq[U]{seq: func(yield func(U, error) bool) {
+		var zero U
+		i := 0
+		for x, err := range m.All() {
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+			y, err := try(f, x, Err[U]).Unbox()
+			if err != nil {
+				yield(zero, elemErr(err, i))
+				return
+			}
+			if !yield(y, nil) {
+				return
+			}
+			i++
+		}
+	}}
+}
+
+// SplitSeq lazily applies a splitting function to each element of a
+// Seq[T], resulting in a Seq of slices of U.  If any function returns an
+// invalid Slice, the sequence ends with that error, wrapped in an
+// ElementError with the element's position in the sequence.  (Unlike Map,
+// this can't be a method, as Go doesn't allow a Seq[T] method to return a
+// Seq[[]T].)
+func SplitSeq[T, U any](m Seq[T], f func(x T) Slice[U]) Seq[[]U] {
+	return MapSeq(m, func(x T) Maybe[[]U] { return New(f(x).Unbox()) })
+}
+
+// SeqToInt lazily applies a function that takes a string and returns an I
+// to each element of a sequence of strings.
+func SeqToInt(m Seq[string], f func(s string) I) Seq[int] {
+	return MapSeq(m, toMaybe(f))
+}
+
+// CollectAoS evaluates a sequence of strings and returns its elements as an
+// AoS.  If the sequence fails, CollectAoS returns an invalid AoS.
+func CollectAoS(m Seq[string]) AoS {
+	return AoS(m.Collect())
+}
+
+// CollectAoI evaluates a sequence of ints and returns its elements as an
+// AoI.  If the sequence fails, CollectAoI returns an invalid AoI.
+func CollectAoI(m Seq[int]) AoI {
+	return AoI(m.Collect())
+}
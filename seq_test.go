@@ -0,0 +1,121 @@
+package maybe_test
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/xdg/maybe"
+	"github.com/xdg/testy"
+)
+
+func TestSeq(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	input := []string{"23", "42", "0"}
+	good := maybe.JustSeq(input)
+	bad := maybe.ErrSeq[string](errors.New("bad strings"))
+
+	just, err := good.Collect().Unbox()
+	is.Equal(just, input)
+	is.Nil(err)
+
+	_, err = bad.Collect().Unbox()
+	is.Equal(err.Error(), "bad strings")
+
+	_, err = maybe.Seq[string]{}.Collect().Unbox()
+	is.NotNil(err)
+
+	// Empty sequences collect to a valid, empty Slice
+	empty, err := maybe.JustSeq([]int{}).Collect().Unbox()
+	is.Equal(empty, []int{})
+	is.Nil(err)
+
+	// Round trip through a named type
+	is.Equal(maybe.CollectAoS(maybe.JustAoS(input).Seq()), maybe.JustAoS(input))
+	is.True(maybe.CollectAoS(maybe.ErrAoS(errors.New("bad")).Seq()).IsErr())
+}
+
+func TestSeqLazy(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	// An endless sequence of numbered lines
+	var produced int
+	lines := maybe.NewSeq(func(yield func(string, error) bool) {
+		for i := 0; ; i++ {
+			produced++
+			if !yield(strconv.Itoa(i), nil) {
+				return
+			}
+		}
+	})
+
+	atoi := func(s string) maybe.I { return maybe.NewI(strconv.Atoi(s)) }
+	odd := func(x int) bool { return x%2 == 1 }
+
+	nums := maybe.SeqToInt(lines, atoi).Filter(odd).Take(3)
+	is.Equal(produced, 0)
+
+	got, err := maybe.CollectAoI(nums).Unbox()
+	is.Equal(got, []int{1, 3, 5})
+	is.Nil(err)
+	is.Equal(produced, 6)
+
+	is.Equal(maybe.CollectAoI(maybe.SeqToInt(lines, atoi).Take(0)), maybe.JustAoI([]int{}))
+}
+
+func TestSeqErrors(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	atoi := func(s string) maybe.I { return maybe.NewI(strconv.Atoi(s)) }
+
+	// The first error ends the sequence
+	var seen []int
+	nums := maybe.SeqToInt(maybe.JustSeq([]string{"1", "x", "2"}), atoi)
+	for x, err := range nums.All() {
+		if err != nil {
+			var ee *maybe.ElementError
+			is.True(errors.As(err, &ee))
+			is.Equal(ee.Index, []int{1})
+			continue
+		}
+		seen = append(seen, x)
+	}
+	is.Equal(seen, []int{1})
+	is.True(maybe.CollectAoI(nums).IsErr())
+
+	// Errors from the source end the sequence too
+	src := maybe.NewSeq(func(yield func(string, error) bool) {
+		if !yield("1", nil) {
+			return
+		}
+		if !yield("", errors.New("read failed")) {
+			return
+		}
+		yield("2", nil)
+	})
+	_, err := maybe.CollectAoS(src).Unbox()
+	is.Equal(err.Error(), "read failed")
+}
+
+func TestSeqSplit(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	lines := maybe.JustSeq([]string{"a b", "c"})
+	fields := func(s string) maybe.Slice[string] { return maybe.JustSlice(strings.Fields(s)) }
+
+	got, err := maybe.SplitSeq(lines, fields).Collect().Unbox()
+	is.Equal(got, [][]string{{"a", "b"}, {"c"}})
+	is.Nil(err)
+
+	upper := lines.Map(func(s string) maybe.Maybe[string] { return maybe.Just(strings.ToUpper(s)) })
+	is.Equal(maybe.CollectAoS(upper), maybe.JustAoS([]string{"A B", "C"}))
+
+	failing := func(s string) maybe.Slice[int] { return maybe.Slice[int]{} }
+	is.True(maybe.SplitSeq(lines, failing).Collect().IsErr())
+}
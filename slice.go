@@ -0,0 +1,606 @@
+package maybe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// Slice implements the Maybe monad for a slice of an arbitrary type T.  A
+// Slice is considered 'valid' or 'invalid' depending on whether it contains a
+// slice or an error value.  A zero-value Slice is invalid and Unbox() will
+// return an error to that effect.
+//
+// AoI, AoS and AoX are defined on top of Slice and convert to and from it
+// without copying, e.g. `maybe.Slice[int](xs)` and `maybe.AoI(s)`.
+type Slice[T any] struct {
+	just []T
+	err  error
+}
+
+// NewSlice constructs a Slice from a given slice or error.  If e is not nil,
+// returns ErrSlice(e), otherwise returns JustSlice(x).
+func NewSlice[T any](x []T, e error) Slice[T] {
+	if e != nil {
+		return ErrSlice[T](e)
+	}
+	return JustSlice(x)
+}
+
+// JustSlice constructs a valid Slice from a given slice.
+func JustSlice[T any](x []T) Slice[T] {
+	return Slice[T]{just: x}
+}
+
+// ErrSlice constructs an invalid Slice from a given error.
+func ErrSlice[T any](e error) Slice[T] {
+	return Slice[T]{err: e}
+}
+
+// NothingSlice constructs an invalid Slice that holds nothing, for a missing
+// rather than a failed value.  See ErrNothing.
+func NothingSlice[T any]() Slice[T] {
+	return Slice[T]{err: ErrNothing}
+}
+
+// IsErr returns true for an invalid Slice.
+func (m Slice[T]) IsErr() bool {
+	return m.just == nil || m.err != nil
+}
+
+// Bind applies a function that takes a slice and returns a Slice.
+func (m Slice[T]) Bind(f func(x []T) Slice[T]) Slice[T] {
+	return BindSlice(m, f)
+}
+
+// BindCtx is like Bind, but the function also takes a context.  See
+// BindSliceCtx for details.
+func (m Slice[T]) BindCtx(ctx context.Context, f func(ctx context.Context, x []T) Slice[T]) Slice[T] {
+	return BindSliceCtx(ctx, m, f)
+}
+
+// Join applies a function that takes a slice and returns a Maybe.
+func (m Slice[T]) Join(f func(x []T) Maybe[T]) Maybe[T] {
+	return JoinSlice(m, f)
+}
+
+// JoinCtx is like Join, but the function also takes a context.  See
+// JoinSliceCtx for details.
+func (m Slice[T]) JoinCtx(ctx context.Context, f func(ctx context.Context, x []T) Maybe[T]) Maybe[T] {
+	return JoinSliceCtx(ctx, m, f)
+}
+
+// Split applies a splitting function to each element of a valid Slice,
+// resulting in a higher-dimension structure. If the Slice is invalid or if
+// any function returns an invalid Slice, Split returns an invalid Grid.
+func (m Slice[T]) Split(f func(x T) Slice[T]) Grid[T] {
+	return SplitSlice(m, f)
+}
+
+// SplitCtx is like Split, but the function also takes a context.  See
+// SplitSliceCtx for details.
+func (m Slice[T]) SplitCtx(ctx context.Context, f func(ctx context.Context, x T) Slice[T]) Grid[T] {
+	return SplitSliceCtx(ctx, m, f)
+}
+
+// Map applies a function to each element of a valid Slice and returns a new
+// Slice.  If the Slice is invalid or if any function returns an invalid
+// Maybe, Map returns an invalid Slice.
+func (m Slice[T]) Map(f func(x T) Maybe[T]) Slice[T] {
+	return MapSlice(m, f)
+}
+
+// MapCtx is like Map, but the function also takes a context.  See
+// MapSliceCtx for details.
+func (m Slice[T]) MapCtx(ctx context.Context, f func(ctx context.Context, x T) Maybe[T]) Slice[T] {
+	return MapSliceCtx(ctx, m, f)
+}
+
+// MapAll is like Map, but applies the function to every element even after
+// a failure.  If any function returns an invalid Maybe, MapAll returns an
+// invalid Slice whose error joins the errors from all failing elements, each
+// tagged with its index.
+func (m Slice[T]) MapAll(f func(x T) Maybe[T]) Slice[T] {
+	return MapSliceAll(m, f)
+}
+
+// MapResults applies a function to each element of a valid Slice and keeps
+// the outcome for every element.  If the Slice is invalid, MapResults returns
+// an invalid Results.
+func (m Slice[T]) MapResults(f func(x T) Maybe[T]) Results[T] {
+	return MapResults(m, f)
+}
+
+// ParallelMap is like Map, but runs the function on up to n elements
+// concurrently.  See ParallelMapSlice for details.
+func (m Slice[T]) ParallelMap(n int, f func(x T) Maybe[T]) Slice[T] {
+	return ParallelMapSlice(m, n, f)
+}
+
+// Filter returns a Slice of the elements of a valid Slice for which a
+// function returns true, in order.  If the Slice is invalid or if any function
+// returns an invalid Maybe, Filter returns an invalid Slice.
+func (m Slice[T]) Filter(f func(x T) Maybe[bool]) Slice[T] {
+	in, _ := partitionSlice(m, f, "Filter")
+	return in
+}
+
+// Reject is the opposite of Filter: it returns a Slice of the elements of a
+// valid Slice for which a function returns false.
+func (m Slice[T]) Reject(f func(x T) Maybe[bool]) Slice[T] {
+	_, out := partitionSlice(m, f, "Reject")
+	return out
+}
+
+// Partition splits a valid Slice into the elements for which a function
+// returns true and those for which it returns false, both in order.  If the
+// Slice is invalid or if any function returns an invalid Maybe, both results
+// are invalid.
+func (m Slice[T]) Partition(f func(x T) Maybe[bool]) (Slice[T], Slice[T]) {
+	return partitionSlice(m, f, "Partition")
+}
+
+// Fold combines the elements of a valid Slice into a single value, starting
+// from an initial value.  See FoldSlice for details.
+func (m Slice[T]) Fold(init T, f func(acc T, x T) Maybe[T]) Maybe[T] {
+	return FoldSlice(m, init, f)
+}
+
+// Scan is like Fold, but returns every intermediate value.  See ScanSlice for
+// details.
+func (m Slice[T]) Scan(init T, f func(acc T, x T) Maybe[T]) Slice[T] {
+	return ScanSlice(m, init, f)
+}
+
+// Zip pairs up the elements of two valid Slices of the same length,
+// resulting in a Grid with a row of two elements for each pair.  If either
+// Slice is invalid or their lengths differ, Zip returns an invalid Grid.
+func (m Slice[T]) Zip(other Slice[T]) Grid[T] {
+	if err := zipCheck(m, other, "Zip"); err != nil {
+		return ErrGrid[T](err)
+	}
+
+	xs := make([][]T, len(m.just))
+	for i, v := range m.just {
+		xs[i] = []T{v, other.just[i]}
+	}
+
+	return JustGrid(xs)
+}
+
+// ZipWith applies a function to each pair of elements of two valid Slices of
+// the same length and returns a new Slice.  See ZipSliceWith for details.
+func (m Slice[T]) ZipWith(other Slice[T], f func(x, y T) Maybe[T]) Slice[T] {
+	return zipSlice(m, other, f, "ZipWith")
+}
+
+// Seq returns a lazy sequence of the elements of the Slice.  If the Slice is
+// invalid, the sequence yields only its error.
+func (m Slice[T]) Seq() Seq[T] {
+	if m.IsErr() {
+		_, err := m.Unbox()
+		return ErrSeq[T](err)
+	}
+	return JustSeq(m.just)
+}
+
+// IsNothing returns true for a Slice that holds nothing, i.e. one whose error
+// is ErrNothing itself.  Such a Slice is also invalid, so IsErr returns true.
+// An error that only wraps ErrNothing, such as an ElementError for a missing
+// element, is an ordinary error.
+func (m Slice[T]) IsNothing() bool {
+	return m.err == ErrNothing
+}
+
+// JustOr returns a valid Slice holding a default value if the Slice holds
+// nothing, or otherwise returns the Slice unchanged.  Unlike OrElse, it leaves
+// other errors in place.  A nil default is replaced by an empty one, as for
+// OrElse.
+func (m Slice[T]) JustOr(x []T) Slice[T] {
+	if m.IsNothing() {
+		return JustSlice(orEmpty(x))
+	}
+	return m
+}
+
+// OrElse returns the Slice if it is valid, or otherwise a valid Slice holding a
+// default value.  A nil default is replaced by an empty one, so the result is
+// always valid.
+func (m Slice[T]) OrElse(x []T) Slice[T] {
+	if m.IsErr() {
+		return JustSlice(orEmpty(x))
+	}
+	return m
+}
+
+// Recover returns the Slice if it is valid, or otherwise a valid Slice holding
+// the value returned by a function that takes the error, with nil replaced by
+// an empty value as for OrElse.
+func (m Slice[T]) Recover(f func(err error) []T) Slice[T] {
+	if !m.IsErr() {
+		return m
+	}
+	_, err := m.Unbox()
+	return try(func(err error) Slice[T] { return JustSlice(orEmpty(f(err))) }, err, ErrSlice[T])
+}
+
+// MapErr returns the Slice if it is valid, or otherwise an invalid Slice with
+// the error returned by a function that takes the original error, e.g. to add
+// context with fmt.Errorf and %w.  If the function returns nil, the original
+// error is kept.
+func (m Slice[T]) MapErr(f func(err error) error) Slice[T] {
+	if !m.IsErr() {
+		return m
+	}
+	_, err := m.Unbox()
+	if e := try(f, err, func(e error) error { return e }); e != nil {
+		return ErrSlice[T](e)
+	}
+	return ErrSlice[T](err)
+}
+
+// Catch is like Recover, but only for errors that match a target according
+// to errors.Is, and the function returns a Slice, so it may fail again.  Valid
+// values and other errors are returned unchanged.
+func (m Slice[T]) Catch(target error, f func(err error) Slice[T]) Slice[T] {
+	if !m.IsErr() {
+		return m
+	}
+	_, err := m.Unbox()
+	if !errors.Is(err, target) {
+		return m
+	}
+	return try(f, err, ErrSlice[T])
+}
+
+// MarshalJSON implements json.Marshaler using the wire format described in
+// the package documentation.
+func (m Slice[T]) MarshalJSON() ([]byte, error) {
+	return marshalJSON(m.Unbox())
+}
+
+// UnmarshalJSON implements json.Unmarshaler using the wire format described
+// in the package documentation.
+func (m *Slice[T]) UnmarshalJSON(b []byte) error {
+	return unmarshalJSON(b, (*Maybe[[]T])(m), "Slice")
+}
+
+// String returns a string representation, mostly useful for debugging.
+func (m Slice[T]) String() string {
+	if m.IsErr() {
+		return fmt.Sprintf("Err %v", m.err)
+	}
+	return fmt.Sprintf("Just %v", m.just)
+}
+
+// checkZero turns a zero-value Slice into an invalid one whose error names
+// the given operation and type, so that a named type delegating to a generic
+// function reports its own method rather than the generic one.
+func (m Slice[T]) checkZero(op, typ string) Slice[T] {
+	if m.just == nil && m.err == nil {
+		return ErrSlice[T](&OpError{Op: op, Type: typ, Err: ErrZeroValue})
+	}
+	return m
+}
+
+// Unbox returns the underlying slice or error.
+func (m Slice[T]) Unbox() ([]T, error) {
+	if m.just == nil && m.err == nil {
+		return nil, &OpError{Op: "Unbox", Type: "Slice", Err: ErrZeroValue}
+	}
+	return m.just, m.err
+}
+
+// BindSlice applies a function that takes a slice of T and returns a
+// Slice[U].
+func BindSlice[T, U any](m Slice[T], f func(x []T) Slice[U]) Slice[U] {
+	if m.IsErr() {
+		return ErrSlice[U](zeroErr(m.err, "BindSlice", "Slice"))
+	}
+
+	return try(f, m.just, ErrSlice[U])
+}
+
+// JoinSlice applies a function that takes a slice of T and returns a
+// Maybe[U].
+func JoinSlice[T, U any](m Slice[T], f func(x []T) Maybe[U]) Maybe[U] {
+	if m.IsErr() {
+		return Err[U](zeroErr(m.err, "JoinSlice", "Slice"))
+	}
+
+	return try(f, m.just, Err[U])
+}
+
+// SplitSlice applies a splitting function to each element of a valid
+// Slice[T], resulting in a Grid[U].  If the Slice is invalid or if any
+// function returns an invalid Slice, SplitSlice returns an invalid Grid.
+func SplitSlice[T, U any](m Slice[T], f func(x T) Slice[U]) Grid[U] {
+	if m.IsErr() {
+		return ErrGrid[U](zeroErr(m.err, "SplitSlice", "Slice"))
+	}
+
+	xss := make([][]U, len(m.just))
+	for i, v := range m.just {
+		xs, err := try(f, v, ErrSlice[U]).Unbox()
+		if err != nil {
+			return ErrGrid[U](elemErr(err, i))
+		}
+		xss[i] = xs
+	}
+
+	return JustGrid(xss)
+}
+
+// MapSlice applies a function to each element of a valid Slice[T] and
+// returns a Slice[U].  If the Slice is invalid or if any function returns an
+// invalid Maybe, MapSlice returns an invalid Slice.
+func MapSlice[T, U any](m Slice[T], f func(x T) Maybe[U]) Slice[U] {
+	if m.IsErr() {
+		return ErrSlice[U](zeroErr(m.err, "MapSlice", "Slice"))
+	}
+
+	xs := make([]U, len(m.just))
+	for i, v := range m.just {
+		x, err := try(f, v, Err[U]).Unbox()
+		if err != nil {
+			return ErrSlice[U](elemErr(err, i))
+		}
+		xs[i] = x
+	}
+
+	return JustSlice(xs)
+}
+
+// MapSliceAll is like MapSlice, but applies the function to every element
+// even after a failure.  If any function returns an invalid Maybe,
+// MapSliceAll returns an invalid Slice whose error joins the errors from all
+// failing elements, each tagged with its index.
+func MapSliceAll[T, U any](m Slice[T], f func(x T) Maybe[U]) Slice[U] {
+	if m.IsErr() {
+		return ErrSlice[U](zeroErr(m.err, "MapSliceAll", "Slice"))
+	}
+
+	xs := make([]U, len(m.just))
+	var errs []error
+	for i, v := range m.just {
+		x, err := try(f, v, Err[U]).Unbox()
+		if err != nil {
+			errs = append(errs, elemErr(err, i))
+			continue
+		}
+		xs[i] = x
+	}
+	if errs != nil {
+		return ErrSlice[U](errors.Join(errs...))
+	}
+
+	return JustSlice(xs)
+}
+
+// Sequence turns a slice of Maybes, or of any of the named types such as I
+// and AoS, into a Slice of their values.  If any element is invalid,
+// Sequence returns an invalid Slice with the error of the first one, tagged
+// with its index.  Validity follows each type's own rules, so an X holding
+// nil or a zero-value AoS counts as invalid.  Convert the result to a named
+// type as needed, e.g. `maybe.AoI(maybe.Sequence(xs))`.
+func Sequence[T any, M ~struct {
+	just T
+	err  error
+}](xs []M) Slice[T] {
+	ys := make([]T, len(xs))
+	for i, v := range xs {
+		if err := argErr[T](v); err != nil {
+			return ErrSlice[T](elemErr(err, i))
+		}
+		ys[i] = Maybe[T](v).just
+	}
+
+	return JustSlice(ys)
+}
+
+// SequenceAll is like Sequence, but if any elements are invalid, it returns
+// an invalid Slice whose error joins the errors from all of them, each
+// tagged with its index.
+func SequenceAll[T any, M ~struct {
+	just T
+	err  error
+}](xs []M) Slice[T] {
+	ys := make([]T, len(xs))
+	var errs []error
+	for i, v := range xs {
+		if err := argErr[T](v); err != nil {
+			errs = append(errs, elemErr(err, i))
+			continue
+		}
+		ys[i] = Maybe[T](v).just
+	}
+	if errs != nil {
+		return ErrSlice[T](errors.Join(errs...))
+	}
+
+	return JustSlice(ys)
+}
+
+// Traverse applies a function that returns a Maybe, or any of the named
+// scalar types, to each element of a plain slice, resulting in a Slice of
+// the values.  It is Map for a slice that isn't boxed yet, e.g. to parse a
+// []string into a Slice[int].  If any function returns an invalid value,
+// Traverse stops and returns an invalid Slice, judging validity as Sequence
+// does.
+func Traverse[T, U any, M ~struct {
+	just U
+	err  error
+}](xs []T, f func(x T) M) Slice[U] {
+	check := func(x T) Maybe[U] {
+		y := f(x)
+		if err := argErr[U](y); err != nil {
+			return Err[U](err)
+		}
+		return Maybe[U](y)
+	}
+	ys := make([]U, len(xs))
+	for i, v := range xs {
+		x, err := try(check, v, Err[U]).Unbox()
+		if err != nil {
+			return ErrSlice[U](elemErr(err, i))
+		}
+		ys[i] = x
+	}
+
+	return JustSlice(ys)
+}
+
+// FoldSlice combines the elements of a valid Slice[T] into a single value of
+// type A by applying a function to an accumulator and each element in turn,
+// starting from an initial value, which is also the result for an empty
+// Slice.  If the Slice is invalid or if any function returns an invalid
+// Maybe, FoldSlice stops and returns an invalid Maybe.
+func FoldSlice[T, A any](m Slice[T], init A, f func(acc A, x T) Maybe[A]) Maybe[A] {
+	if m.IsErr() {
+		return Err[A](zeroErr(m.err, "FoldSlice", "Slice"))
+	}
+
+	acc := init
+	for i, v := range m.just {
+		x, err := try(func(v T) Maybe[A] { return f(acc, v) }, v, Err[A]).Unbox()
+		if err != nil {
+			return Err[A](elemErr(err, i))
+		}
+		acc = x
+	}
+
+	return Just(acc)
+}
+
+// ScanSlice is like FoldSlice, but returns a Slice[A] of the accumulator
+// after each element, e.g. running totals.  If the Slice is invalid or if any
+// function returns an invalid Maybe, ScanSlice stops and returns an invalid
+// Slice.
+func ScanSlice[T, A any](m Slice[T], init A, f func(acc A, x T) Maybe[A]) Slice[A] {
+	if m.IsErr() {
+		return ErrSlice[A](zeroErr(m.err, "ScanSlice", "Slice"))
+	}
+
+	xs := make([]A, len(m.just))
+	acc := init
+	for i, v := range m.just {
+		x, err := try(func(v T) Maybe[A] { return f(acc, v) }, v, Err[A]).Unbox()
+		if err != nil {
+			return ErrSlice[A](elemErr(err, i))
+		}
+		acc, xs[i] = x, x
+	}
+
+	return JustSlice(xs)
+}
+
+// Pair holds two values of possibly different types, e.g. the elements of
+// two Slices combined by ZipSlice.
+type Pair[A, B any] struct {
+	First  A
+	Second B
+}
+
+// ZipSlice pairs up the elements of a valid Slice[A] and a valid Slice[B],
+// resulting in a Slice of Pairs.  If either Slice is invalid, ZipSlice
+// returns an invalid Slice with the error of the first invalid one.  If the
+// Slices have different lengths, the error wraps ErrLengthMismatch.
+func ZipSlice[A, B any](a Slice[A], b Slice[B]) Slice[Pair[A, B]] {
+	if err := zipCheck(a, b, "ZipSlice"); err != nil {
+		return ErrSlice[Pair[A, B]](err)
+	}
+
+	xs := make([]Pair[A, B], len(a.just))
+	for i, v := range a.just {
+		xs[i] = Pair[A, B]{v, b.just[i]}
+	}
+
+	return JustSlice(xs)
+}
+
+// ZipSliceWith is like ZipSlice, but applies a function to each pair of
+// elements, resulting in a Slice[C].  If any function returns an invalid
+// Maybe, ZipSliceWith returns an invalid Slice.
+func ZipSliceWith[A, B, C any](a Slice[A], b Slice[B], f func(x A, y B) Maybe[C]) Slice[C] {
+	return zipSlice(a, b, f, "ZipSliceWith")
+}
+
+// UnzipSlice splits a valid Slice of Pairs into a Slice of the first
+// elements and a Slice of the second elements.  If the Slice is invalid,
+// both results are invalid.
+func UnzipSlice[A, B any](m Slice[Pair[A, B]]) (Slice[A], Slice[B]) {
+	if m.IsErr() {
+		err := zeroErr(m.err, "UnzipSlice", "Slice")
+		return ErrSlice[A](err), ErrSlice[B](err)
+	}
+
+	xs, ys := make([]A, len(m.just)), make([]B, len(m.just))
+	for i, v := range m.just {
+		xs[i], ys[i] = v.First, v.Second
+	}
+
+	return JustSlice(xs), JustSlice(ys)
+}
+
+func zipCheck[A, B any](a Slice[A], b Slice[B], op string) error {
+	if a.IsErr() {
+		return zeroErr(a.err, op, "Slice")
+	}
+	if b.IsErr() {
+		return zeroErr(b.err, op, "Slice")
+	}
+	if len(a.just) != len(b.just) {
+		err := fmt.Errorf("%w: %d and %d elements", ErrLengthMismatch, len(a.just), len(b.just))
+		return &OpError{Op: op, Type: "Slice", Err: err}
+	}
+	return nil
+}
+
+func zipSlice[A, B, C any](a Slice[A], b Slice[B], f func(x A, y B) Maybe[C], op string) Slice[C] {
+	if err := zipCheck(a, b, op); err != nil {
+		return ErrSlice[C](err)
+	}
+
+	xs := make([]C, len(a.just))
+	for i, v := range a.just {
+		x, err := try(func(v A) Maybe[C] { return f(v, b.just[i]) }, v, Err[C]).Unbox()
+		if err != nil {
+			return ErrSlice[C](elemErr(err, i))
+		}
+		xs[i] = x
+	}
+
+	return JustSlice(xs)
+}
+
+func partitionSlice[T any](m Slice[T], f func(x T) Maybe[bool], op string) (Slice[T], Slice[T]) {
+	if m.IsErr() {
+		err := zeroErr(m.err, op, "Slice")
+		return ErrSlice[T](err), ErrSlice[T](err)
+	}
+
+	in, out := make([]T, 0), make([]T, 0)
+	for i, v := range m.just {
+		ok, err := try(f, v, Err[bool]).Unbox()
+		if err != nil {
+			err = elemErr(err, i)
+			return ErrSlice[T](err), ErrSlice[T](err)
+		}
+		if ok {
+			in = append(in, v)
+		} else {
+			out = append(out, v)
+		}
+	}
+
+	return JustSlice(in), JustSlice(out)
+}
+
+// orEmpty replaces a nil slice with an empty one, as a nil slice makes a
+// Slice invalid.
+func orEmpty[T any](x []T) []T {
+	if x == nil {
+		return []T{}
+	}
+	return x
+}
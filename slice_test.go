@@ -0,0 +1,387 @@
+package maybe_test
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/xdg/maybe"
+	"github.com/xdg/testy"
+)
+
+func getSliceFixtures(input []float64) (good, bad maybe.Slice[float64]) {
+	good = maybe.JustSlice(input)
+	bad = maybe.ErrSlice[float64](errors.New("bad floats"))
+	return
+}
+
+func TestSlice(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	input := []float64{1.5, 2.5}
+	good, bad := getSliceFixtures(input)
+	var got maybe.Slice[float64]
+	var just []float64
+	var err error
+
+	just, err = good.Unbox()
+	is.Equal(just, input)
+	is.Nil(err)
+	is.False(good.IsErr())
+
+	just, err = bad.Unbox()
+	is.Nil(just)
+	is.NotNil(err)
+	is.Equal(err.Error(), "bad floats")
+	is.True(bad.IsErr())
+
+	got = maybe.NewSlice(input, nil)
+	is.Equal(got, good)
+
+	got = maybe.NewSlice[float64](nil, err)
+	is.True(got.IsErr())
+
+	is.Equal(good.String(), "Just [1.5 2.5]")
+	is.Equal(bad.String(), "Err bad floats")
+
+	// Check zero value case
+	zero := maybe.Slice[float64]{}
+	is.True(zero.IsErr())
+	_, err = zero.Unbox()
+	is.NotNil(err)
+}
+
+func TestSliceBindJoin(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	good, bad := getSliceFixtures([]float64{1.5, 2.5})
+
+	tail := func(xs []float64) maybe.Slice[float64] { return maybe.JustSlice(xs[1:]) }
+	just, err := good.Bind(tail).Unbox()
+	is.Equal(just, []float64{2.5})
+	is.Nil(err)
+	is.True(bad.Bind(tail).IsErr())
+
+	sum := func(xs []float64) maybe.Maybe[float64] {
+		var total float64
+		for _, v := range xs {
+			total += v
+		}
+		return maybe.Just(total)
+	}
+	total, err := good.Join(sum).Unbox()
+	is.Equal(total, 4.0)
+	is.Nil(err)
+	is.True(bad.Join(sum).IsErr())
+
+	// Type-changing variants
+	count := func(xs []float64) maybe.Maybe[int] { return maybe.Just(len(xs)) }
+	n, err := maybe.JoinSlice(good, count).Unbox()
+	is.Equal(n, 2)
+	is.Nil(err)
+
+	strs := func(xs []float64) maybe.Slice[string] {
+		out := make([]string, len(xs))
+		for i, v := range xs {
+			out[i] = strconv.FormatFloat(v, 'f', -1, 64)
+		}
+		return maybe.JustSlice(out)
+	}
+	s, err := maybe.BindSlice(good, strs).Unbox()
+	is.Equal(s, []string{"1.5", "2.5"})
+	is.Nil(err)
+	is.True(maybe.BindSlice(bad, strs).IsErr())
+}
+
+func TestSliceMap(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	good := maybe.JustSlice([]string{"23", "42"})
+	bad := maybe.ErrSlice[string](errors.New("bad strings"))
+
+	atoi := func(s string) maybe.Maybe[int] { return maybe.New(strconv.Atoi(s)) }
+
+	just, err := maybe.MapSlice(good, atoi).Unbox()
+	is.Equal(just, []int{23, 42})
+	is.Nil(err)
+
+	is.True(maybe.MapSlice(bad, atoi).IsErr())
+	is.True(maybe.MapSlice(maybe.JustSlice([]string{"23", "x"}), atoi).IsErr())
+
+	upper := good.Map(func(s string) maybe.Maybe[string] { return maybe.Just(s + "!") })
+	strs, err := upper.Unbox()
+	is.Equal(strs, []string{"23!", "42!"})
+	is.Nil(err)
+
+	// Results convert to the named types
+	is.Equal(maybe.AoI(maybe.MapSlice(good, atoi)), maybe.JustAoI([]int{23, 42}))
+}
+
+func TestSliceSplit(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	good := maybe.JustSlice([]string{"a b", "c"})
+	bad := maybe.ErrSlice[string](errors.New("bad strings"))
+
+	fields := func(s string) maybe.Slice[string] { return maybe.JustSlice(strings.Fields(s)) }
+	just, err := good.Split(fields).Unbox()
+	is.Equal(just, [][]string{{"a", "b"}, {"c"}})
+	is.Nil(err)
+	is.True(bad.Split(fields).IsErr())
+
+	runes := func(s string) maybe.Slice[rune] { return maybe.JustSlice([]rune(s)) }
+	rs, err := maybe.SplitSlice(good, runes).Unbox()
+	is.Equal(rs, [][]rune{{'a', ' ', 'b'}, {'c'}})
+	is.Nil(err)
+
+	failing := func(s string) maybe.Slice[rune] { return maybe.ErrSlice[rune](errors.New("no")) }
+	is.True(maybe.SplitSlice(good, failing).IsErr())
+}
+
+func TestSliceMapAll(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	input := maybe.JustSlice([]string{"x", "42", "y"})
+	atoi := func(s string) maybe.Maybe[int] { return maybe.New(strconv.Atoi(s)) }
+
+	_, err := maybe.MapSliceAll(input, atoi).Unbox()
+	is.Equal(err.Error(), "element [0]: strconv.Atoi: parsing \"x\": invalid syntax\n"+
+		"element [2]: strconv.Atoi: parsing \"y\": invalid syntax")
+	is.True(errors.Is(err, strconv.ErrSyntax))
+
+	just, err := maybe.MapSliceAll(maybe.JustSlice([]string{"23", "42"}), atoi).Unbox()
+	is.Equal(just, []int{23, 42})
+	is.Nil(err)
+
+	is.True(maybe.MapSliceAll(maybe.ErrSlice[string](errors.New("bad")), atoi).IsErr())
+}
+
+func TestSliceRecovery(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	good := maybe.JustSlice([]int{1})
+	bad := maybe.ErrSlice[int](errors.New("bad"))
+
+	is.Equal(good.OrElse([]int{}), good)
+	xs, err := bad.OrElse([]int{}).Unbox()
+	is.Equal(xs, []int{})
+	is.Nil(err)
+
+	// A nil default is replaced by an empty one
+	xs, err = bad.OrElse(nil).Unbox()
+	is.Equal(xs, []int{})
+	is.Nil(err)
+	is.False(bad.Recover(func(err error) []int { return nil }).IsErr())
+	is.False(maybe.ErrGrid[int](errors.New("bad")).OrElse(nil).IsErr())
+	is.False(maybe.ErrDict[int](errors.New("bad")).OrElse(nil).IsErr())
+
+	// A zero value is recovered too, and its error is passed to the function
+	var got error
+	xs, err = maybe.Slice[int]{}.Recover(func(err error) []int { got = err; return []int{0} }).Unbox()
+	is.Equal(xs, []int{0})
+	is.Nil(err)
+	is.True(errors.Is(got, maybe.ErrZeroValue))
+
+	_, err = maybe.Slice[int]{}.MapErr(func(err error) error { return errors.New("empty input") }).Unbox()
+	is.Equal(err.Error(), "empty input")
+
+	is.False(maybe.Slice[int]{}.Catch(maybe.ErrZeroValue, func(err error) maybe.Slice[int] {
+		return maybe.JustSlice([]int{})
+	}).IsErr())
+	is.True(bad.Catch(maybe.ErrZeroValue, func(err error) maybe.Slice[int] {
+		return maybe.JustSlice([]int{})
+	}).IsErr())
+}
+
+func TestSliceFilter(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	even := func(x int) maybe.Maybe[bool] { return maybe.Just(x%2 == 0) }
+	small := func(x int) maybe.Maybe[bool] {
+		if x > 9 {
+			return maybe.Err[bool](errors.New("too big"))
+		}
+		return maybe.Just(x < 5)
+	}
+	good := maybe.JustSlice([]int{1, 2, 3, 4})
+
+	xs, err := good.Filter(even).Unbox()
+	is.Equal(xs, []int{2, 4})
+	is.Nil(err)
+
+	xs, err = good.Reject(even).Unbox()
+	is.Equal(xs, []int{1, 3})
+	is.Nil(err)
+
+	in, out := good.Partition(even)
+	is.Equal(in, maybe.JustSlice([]int{2, 4}))
+	is.Equal(out, maybe.JustSlice([]int{1, 3}))
+
+	xs, err = good.Filter(func(x int) maybe.Maybe[bool] { return maybe.Just(false) }).Unbox()
+	is.Equal(xs, []int{})
+	is.Nil(err)
+
+	in, out = maybe.JustSlice([]int{1, 10}).Partition(small)
+	_, err = in.Unbox()
+	is.Equal(err.Error(), "element [1]: too big")
+	is.True(out.IsErr())
+
+	_, err = maybe.Slice[int]{}.Reject(even).Unbox()
+	is.Equal(err.Error(), "Slice.Reject: zero value")
+	is.True(maybe.ErrSlice[int](errors.New("bad")).Filter(even).IsErr())
+}
+
+func TestSliceFold(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	add := func(acc, x int) maybe.Maybe[int] {
+		if x < 0 {
+			return maybe.Err[int](errors.New("negative"))
+		}
+		return maybe.Just(acc + x)
+	}
+	good := maybe.JustSlice([]int{1, 2, 3, 4})
+
+	is.Equal(good.Fold(10, add), maybe.Just(20))
+	is.Equal(good.Scan(0, add), maybe.JustSlice([]int{1, 3, 6, 10}))
+	is.Equal(maybe.JustSlice([]int{}).Fold(10, add), maybe.Just(10))
+	is.Equal(maybe.JustSlice([]int{}).Scan(10, add), maybe.JustSlice([]int{}))
+
+	// Accumulator can have a different type
+	concat := func(acc string, x int) maybe.Maybe[string] { return maybe.Just(acc + strconv.Itoa(x)) }
+	is.Equal(maybe.FoldSlice(good, ">", concat), maybe.Just(">1234"))
+	is.Equal(maybe.ScanSlice(good, "", concat), maybe.JustSlice([]string{"1", "12", "123", "1234"}))
+
+	// Errors short-circuit with the index of the failing element
+	calls := 0
+	counted := func(acc, x int) maybe.Maybe[int] { calls++; return add(acc, x) }
+	_, err := maybe.JustSlice([]int{1, -2, 3}).Fold(0, counted).Unbox()
+	is.Equal(err.Error(), "element [1]: negative")
+	is.Equal(calls, 2)
+	_, err = maybe.JustSlice([]int{1, -2, 3}).Scan(0, add).Unbox()
+	is.Equal(err.Error(), "element [1]: negative")
+
+	_, err = maybe.Slice[int]{}.Fold(0, add).Unbox()
+	is.Equal(err.Error(), "Slice.FoldSlice: zero value")
+	is.True(maybe.ErrSlice[int](errors.New("bad")).Scan(0, add).IsErr())
+}
+
+func TestSliceZip(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	names := maybe.JustSlice([]string{"a", "b"})
+	counts := maybe.JustSlice([]int{1, 2})
+
+	pairs := maybe.ZipSlice(names, counts)
+	is.Equal(pairs, maybe.JustSlice([]maybe.Pair[string, int]{{"a", 1}, {"b", 2}}))
+	xs, ys := maybe.UnzipSlice(pairs)
+	is.Equal(xs, names)
+	is.Equal(ys, counts)
+
+	repeat := func(s string, n int) maybe.Maybe[string] {
+		if n < 0 {
+			return maybe.Err[string](errors.New("negative count"))
+		}
+		return maybe.Just(strings.Repeat(s, n))
+	}
+	is.Equal(maybe.ZipSliceWith(names, counts, repeat), maybe.JustSlice([]string{"a", "bb"}))
+	_, err := maybe.ZipSliceWith(names, maybe.JustSlice([]int{1, -2}), repeat).Unbox()
+	is.Equal(err.Error(), "element [1]: negative count")
+
+	// Same-typed Slices zip into a Grid and back
+	grid := names.Zip(maybe.JustSlice([]string{"x", "y"}))
+	is.Equal(grid, maybe.JustGrid([][]string{{"a", "x"}, {"b", "y"}}))
+	left, right := grid.Unzip()
+	is.Equal(left, names)
+	is.Equal(right, maybe.JustSlice([]string{"x", "y"}))
+	is.Equal(counts.ZipWith(counts, func(x, y int) maybe.Maybe[int] { return maybe.Just(x * y) }), maybe.JustSlice([]int{1, 4}))
+
+	// Lengths must match
+	_, err = maybe.ZipSlice(names, maybe.JustSlice([]int{1})).Unbox()
+	is.True(errors.Is(err, maybe.ErrLengthMismatch))
+	is.Equal(err.Error(), "Slice.ZipSlice: length mismatch: 2 and 1 elements")
+
+	// The first error wins
+	_, err = maybe.ZipSlice(maybe.ErrSlice[string](errors.New("left")), maybe.ErrSlice[int](errors.New("right"))).Unbox()
+	is.Equal(err.Error(), "left")
+	_, err = names.Zip(maybe.ErrSlice[string](errors.New("right"))).Unbox()
+	is.Equal(err.Error(), "right")
+	_, err = maybe.Slice[string]{}.Zip(names).Unbox()
+	is.Equal(err.Error(), "Slice.Zip: zero value")
+
+	xs, ys = maybe.UnzipSlice(maybe.ErrSlice[maybe.Pair[string, int]](errors.New("bad")))
+	is.True(xs.IsErr())
+	is.True(ys.IsErr())
+}
+
+func TestSequence(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	good := []maybe.Maybe[int]{maybe.Just(1), maybe.Just(2)}
+	is.Equal(maybe.Sequence(good), maybe.JustSlice([]int{1, 2}))
+	is.Equal(maybe.SequenceAll(good), maybe.JustSlice([]int{1, 2}))
+	is.Equal(maybe.Sequence([]maybe.S{}), maybe.JustSlice([]string{}))
+
+	bad := []maybe.I{maybe.JustI(1), maybe.ErrI(errors.New("two")), maybe.ErrI(errors.New("three"))}
+	_, err := maybe.Sequence(bad).Unbox()
+	is.Equal(err.Error(), "element [1]: two")
+	_, err = maybe.SequenceAll(bad).Unbox()
+	is.Equal(err.Error(), "element [1]: two\nelement [2]: three")
+
+	is.Equal(maybe.Traverse([]string{"1", "2"}, func(s string) maybe.I { return maybe.NewI(strconv.Atoi(s)) }), maybe.JustSlice([]int{1, 2}))
+	is.Equal(maybe.Traverse(nil, func(s string) maybe.Maybe[int] { return maybe.Just(len(s)) }), maybe.JustSlice([]int{}))
+	_, err = maybe.Traverse([]string{"1", "x"}, func(s string) maybe.I { return maybe.NewI(strconv.Atoi(s)) }).Unbox()
+	is.True(errors.Is(err, strconv.ErrSyntax))
+	is.Equal(err.(*maybe.ElementError).Index, []int{1})
+
+	// An X holding nil is invalid, as for X.Bind
+	xs := []maybe.X{maybe.JustX(1), maybe.JustX(nil)}
+	_, err = maybe.Sequence(xs).Unbox()
+	is.Equal(err.Error(), "element [1]: X.Unbox: nil value")
+	_, err = maybe.SequenceAll(xs).Unbox()
+	is.True(errors.Is(err, maybe.ErrNilValue))
+	_, err = maybe.Traverse([]int{0}, func(int) maybe.X { return maybe.JustX(nil) }).Unbox()
+	is.True(errors.Is(err, maybe.ErrNilValue))
+
+	// So is a zero-value container, rather than an empty row
+	rows := []maybe.AoI{maybe.JustAoI([]int{1}), {}}
+	_, err = maybe.Sequence(rows).Unbox()
+	is.Equal(err.Error(), "element [1]: AoI.Unbox: zero value")
+	_, err = maybe.SequenceAll(rows).Unbox()
+	is.True(errors.Is(err, maybe.ErrZeroValue))
+	is.Equal(maybe.AoAoI(maybe.Sequence(rows[:1])), maybe.JustAoAoI([][]int{{1}}))
+}
+
+func TestSliceNothing(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	n := maybe.NothingSlice[int]()
+	is.True(n.IsNothing())
+	is.Equal(n.JustOr([]int{1}), maybe.JustSlice([]int{1}))
+	is.True(maybe.MapSlice(n, func(x int) maybe.Maybe[string] { return maybe.Just(strconv.Itoa(x)) }).IsNothing())
+
+	// An element that is Nothing makes the whole Slice invalid, but with an
+	// ordinary element error rather than as Nothing
+	lookup := func(x int) maybe.Maybe[int] { return maybe.FromOK(map[int]int{1: 10}[x], x == 1) }
+	got := maybe.JustSlice([]int{1, 2}).Map(lookup)
+	is.False(got.IsNothing())
+	_, err := got.Unbox()
+	is.Equal(err.Error(), "element [1]: nothing")
+	is.False(maybe.JustSlice([]int{1}).Map(lookup).IsNothing())
+
+	is.False(maybe.Sequence([]maybe.Maybe[int]{maybe.Just(1), maybe.Nothing[int]()}).IsNothing())
+	is.True(maybe.NothingGrid[int]().IsNothing())
+	is.False(maybe.JustGrid([][]int{}).IsNothing())
+}
@@ -0,0 +1,133 @@
+package maybe
+
+import (
+	"fmt"
+	"time"
+)
+
+// T implements the Maybe monad for a time.Time.  A T is considered 'valid' or
+// 'invalid' depending on whether it contains a time.Time or an error value.
+type T Maybe[time.Time]
+
+// NewT constructs a T from a given time.Time or error. If e is not nil, returns
+// ErrT(e), otherwise returns JustT(x).
+func NewT(x time.Time, e error) T {
+	if e != nil {
+		return ErrT(e)
+	}
+	return JustT(x)
+}
+
+// JustT constructs a valid T from a given time.Time.
+func JustT(x time.Time) T {
+	return T{just: x}
+}
+
+// ErrT constructs an invalid T from a given error.
+func ErrT(e error) T {
+	return T{err: e}
+}
+
+// NothingT constructs an invalid T that holds nothing, for a missing rather
+// than a failed value.  See ErrNothing.
+func NothingT() T {
+	return T{err: ErrNothing}
+}
+
+// IsErr returns true for an invalid T.
+func (m T) IsErr() bool {
+	return m.err != nil
+}
+
+// Bind applies a function that takes a time.Time and returns a T.
+func (m T) Bind(f func(x time.Time) T) T {
+	if m.err != nil {
+		return m
+	}
+
+	return try(f, m.just, ErrT)
+}
+
+// Split applies a function that takes a time.Time and returns an AoT.
+func (m T) Split(f func(x time.Time) AoT) AoT {
+	if m.err != nil {
+		return ErrAoT(m.err)
+	}
+
+	return try(f, m.just, ErrAoT)
+}
+
+// Format formats a valid T according to a layout, as time.Time.Format does,
+// resulting in an S.  If the T is invalid, Format returns an invalid S.
+func (m T) Format(layout string) S {
+	if m.err != nil {
+		return ErrS(m.err)
+	}
+
+	return JustS(m.just.Format(layout))
+}
+
+// IsNothing returns true for a T that holds nothing, i.e. one whose error is
+// ErrNothing itself.  Such a T is also invalid, so IsErr returns true.
+func (m T) IsNothing() bool {
+	return Maybe[time.Time](m).IsNothing()
+}
+
+// JustOr returns a valid T holding a default value if the T holds nothing, or
+// otherwise returns the T unchanged.  Unlike OrElse, it leaves other errors in
+// place.
+func (m T) JustOr(x time.Time) T {
+	return T(Maybe[time.Time](m).JustOr(x))
+}
+
+// OrElse returns the T if it is valid, or otherwise a valid T holding a default
+// value.
+func (m T) OrElse(x time.Time) T {
+	return T(Maybe[time.Time](m).OrElse(x))
+}
+
+// Recover returns the T if it is valid, or otherwise a valid T holding the
+// value returned by a function that takes the error.
+func (m T) Recover(f func(err error) time.Time) T {
+	return T(Maybe[time.Time](m).Recover(f))
+}
+
+// MapErr returns the T if it is valid, or otherwise an invalid T with the
+// error returned by a function that takes the original error, e.g. to add
+// context with fmt.Errorf and %w.  If the function returns nil, the original
+// error is kept.
+func (m T) MapErr(f func(err error) error) T {
+	return T(Maybe[time.Time](m).MapErr(f))
+}
+
+// Catch is like Recover, but only for errors that match a target according
+// to errors.Is, and the function returns a T, so it may fail again.  Valid
+// values and other errors are returned unchanged.
+func (m T) Catch(target error, f func(err error) T) T {
+	return T(Maybe[time.Time](m).Catch(target, toMaybe(f)))
+}
+
+// MarshalJSON implements json.Marshaler using the wire format described in
+// the package documentation.
+func (m T) MarshalJSON() ([]byte, error) {
+	return marshalJSON(m.Unbox())
+}
+
+// UnmarshalJSON implements json.Unmarshaler using the wire format described
+// in the package documentation.
+func (m *T) UnmarshalJSON(b []byte) error {
+	return unmarshalJSON(b, (*Maybe[time.Time])(m), "T")
+}
+
+// String returns a string representation, mostly useful for debugging.
+func (m T) String() string {
+	if m.err != nil {
+		return fmt.Sprintf("Err %v", m.err)
+	}
+	return fmt.Sprintf("Just %v", m.just)
+}
+
+// Unbox returns the underlying time.Time value or error.
+func (m T) Unbox() (time.Time, error) {
+	return m.just, m.err
+}
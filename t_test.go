@@ -0,0 +1,64 @@
+package maybe_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/xdg/maybe"
+	"github.com/xdg/testy"
+)
+
+func TestTime(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	input := time.Date(2024, 3, 1, 12, 30, 0, 0, time.UTC)
+	good := maybe.JustT(input)
+	bad := maybe.ErrT(errors.New("bad time"))
+
+	just, err := good.Unbox()
+	is.Equal(just, input)
+	is.Nil(err)
+	is.False(good.IsErr())
+
+	just, err = bad.Unbox()
+	is.Equal(just, time.Time{})
+	is.Equal(err.Error(), "bad time")
+	is.True(bad.IsErr())
+
+	is.Equal(maybe.NewT(input, nil), good)
+	is.True(maybe.NewT(time.Time{}, err).IsErr())
+
+	is.Equal(good.String(), "Just 2024-03-01 12:30:00 +0000 UTC")
+	is.Equal(bad.String(), "Err bad time")
+
+	later := func(x time.Time) maybe.T { return maybe.JustT(x.Add(time.Hour)) }
+	just, err = good.Bind(later).Unbox()
+	is.Equal(just, input.Add(time.Hour))
+	is.Nil(err)
+	is.True(bad.Bind(later).IsErr())
+
+	both := func(x time.Time) maybe.AoT { return maybe.JustAoT([]time.Time{x, x}) }
+	xs, err := good.Split(both).Unbox()
+	is.Equal(xs, []time.Time{input, input})
+	is.Nil(err)
+	is.True(bad.Split(both).IsErr())
+}
+
+func TestTimeParseFormat(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	just, err := maybe.JustS("2024-03-01").ToTime(time.DateOnly).Unbox()
+	is.Equal(just, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC))
+	is.Nil(err)
+
+	is.True(maybe.JustS("March 1").ToTime(time.DateOnly).IsErr())
+	is.True(maybe.ErrS(errors.New("bad string")).ToTime(time.DateOnly).IsErr())
+
+	s, err := maybe.JustS("2024-03-01T12:30:00Z").ToTime(time.RFC3339).Format(time.Kitchen).Unbox()
+	is.Equal(s, "12:30PM")
+	is.Nil(err)
+	is.True(maybe.ErrT(errors.New("bad time")).Format(time.Kitchen).IsErr())
+}
@@ -0,0 +1,150 @@
+package maybe
+
+import "fmt"
+
+// U64 implements the Maybe monad for a uint64.  A U64 is considered 'valid' or
+// 'invalid' depending on whether it contains a uint64 or an error value.
+type U64 Maybe[uint64]
+
+// NewU64 constructs a U64 from a given uint64 or error. If e is not nil,
+// returns ErrU64(e), otherwise returns JustU64(x).
+func NewU64(x uint64, e error) U64 {
+	if e != nil {
+		return ErrU64(e)
+	}
+	return JustU64(x)
+}
+
+// JustU64 constructs a valid U64 from a given uint64.
+func JustU64(x uint64) U64 {
+	return U64{just: x}
+}
+
+// ErrU64 constructs an invalid U64 from a given error.
+func ErrU64(e error) U64 {
+	return U64{err: e}
+}
+
+// NothingU64 constructs an invalid U64 that holds nothing, for a missing rather
+// than a failed value.  See ErrNothing.
+func NothingU64() U64 {
+	return U64{err: ErrNothing}
+}
+
+// IsErr returns true for an invalid U64.
+func (m U64) IsErr() bool {
+	return m.err != nil
+}
+
+// Bind applies a function that takes a uint64 and returns a U64.
+func (m U64) Bind(f func(x uint64) U64) U64 {
+	if m.err != nil {
+		return m
+	}
+
+	return try(f, m.just, ErrU64)
+}
+
+// Split applies a function that takes a uint64 and returns an AoU64.
+func (m U64) Split(f func(x uint64) AoU64) AoU64 {
+	if m.err != nil {
+		return ErrAoU64(m.err)
+	}
+
+	return try(f, m.just, ErrAoU64)
+}
+
+// ToI converts a valid U64 to an I.  If the U64 is invalid or its value is out
+// of range for int, ToI returns an invalid I.
+func (m U64) ToI() I {
+	if m.err != nil {
+		return ErrI(m.err)
+	}
+
+	return I(u64ToI(m.just))
+}
+
+// ToI64 converts a valid U64 to an I64.  If the U64 is invalid or its value is
+// out of range for int64, ToI64 returns an invalid I64.
+func (m U64) ToI64() I64 {
+	if m.err != nil {
+		return ErrI64(m.err)
+	}
+
+	return I64(u64ToI64(m.just))
+}
+
+// ToBigI converts a valid U64 to a BigI.  If the U64 is invalid, ToBigI returns
+// an invalid BigI.
+func (m U64) ToBigI() BigI {
+	if m.err != nil {
+		return ErrBigI(m.err)
+	}
+
+	return BigI(u64ToBigI(m.just))
+}
+
+// IsNothing returns true for a U64 that holds nothing, i.e. one whose error is
+// ErrNothing itself.  Such a U64 is also invalid, so IsErr returns true.
+func (m U64) IsNothing() bool {
+	return Maybe[uint64](m).IsNothing()
+}
+
+// JustOr returns a valid U64 holding a default value if the U64 holds nothing,
+// or otherwise returns the U64 unchanged.  Unlike OrElse, it leaves other
+// errors in place.
+func (m U64) JustOr(x uint64) U64 {
+	return U64(Maybe[uint64](m).JustOr(x))
+}
+
+// OrElse returns the U64 if it is valid, or otherwise a valid U64 holding a
+// default value.
+func (m U64) OrElse(x uint64) U64 {
+	return U64(Maybe[uint64](m).OrElse(x))
+}
+
+// Recover returns the U64 if it is valid, or otherwise a valid U64 holding the
+// value returned by a function that takes the error.
+func (m U64) Recover(f func(err error) uint64) U64 {
+	return U64(Maybe[uint64](m).Recover(f))
+}
+
+// MapErr returns the U64 if it is valid, or otherwise an invalid U64 with the
+// error returned by a function that takes the original error, e.g. to add
+// context with fmt.Errorf and %w.  If the function returns nil, the original
+// error is kept.
+func (m U64) MapErr(f func(err error) error) U64 {
+	return U64(Maybe[uint64](m).MapErr(f))
+}
+
+// Catch is like Recover, but only for errors that match a target according
+// to errors.Is, and the function returns a U64, so it may fail again.  Valid
+// values and other errors are returned unchanged.
+func (m U64) Catch(target error, f func(err error) U64) U64 {
+	return U64(Maybe[uint64](m).Catch(target, toMaybe(f)))
+}
+
+// MarshalJSON implements json.Marshaler using the wire format described in
+// the package documentation.
+func (m U64) MarshalJSON() ([]byte, error) {
+	return marshalJSON(m.Unbox())
+}
+
+// UnmarshalJSON implements json.Unmarshaler using the wire format described
+// in the package documentation.
+func (m *U64) UnmarshalJSON(b []byte) error {
+	return unmarshalJSON(b, (*Maybe[uint64])(m), "U64")
+}
+
+// String returns a string representation, mostly useful for debugging.
+func (m U64) String() string {
+	if m.err != nil {
+		return fmt.Sprintf("Err %v", m.err)
+	}
+	return fmt.Sprintf("Just %v", m.just)
+}
+
+// Unbox returns the underlying uint64 value or error.
+func (m U64) Unbox() (uint64, error) {
+	return m.just, m.err
+}
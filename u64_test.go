@@ -0,0 +1,81 @@
+package maybe_test
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/xdg/maybe"
+	"github.com/xdg/testy"
+)
+
+func TestUint64(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	good := maybe.JustU64(math.MaxUint64)
+	bad := maybe.ErrU64(errors.New("bad uint64"))
+
+	just, err := good.Unbox()
+	is.Equal(just, uint64(math.MaxUint64))
+	is.Nil(err)
+	is.False(good.IsErr())
+
+	just, err = bad.Unbox()
+	is.Equal(just, uint64(0))
+	is.Equal(err.Error(), "bad uint64")
+	is.True(bad.IsErr())
+
+	is.Equal(maybe.NewU64(math.MaxUint64, nil), good)
+	is.True(maybe.NewU64(0, err).IsErr())
+
+	is.Equal(good.String(), "Just 18446744073709551615")
+	is.Equal(bad.String(), "Err bad uint64")
+
+	inc := func(x uint64) maybe.U64 { return maybe.JustU64(x + 1) }
+	just, err = maybe.JustU64(1).Bind(inc).Unbox()
+	is.Equal(just, uint64(2))
+	is.Nil(err)
+	is.True(bad.Bind(inc).IsErr())
+}
+
+func TestUint64Conversions(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	just, err := maybe.JustS("18446744073709551615").ToU64().Unbox()
+	is.Equal(just, uint64(math.MaxUint64))
+	is.Nil(err)
+
+	_, err = maybe.JustS("18446744073709551616").ToU64().Unbox()
+	is.True(errors.Is(err, strconv.ErrRange))
+	_, err = maybe.JustS("-1").ToU64().Unbox()
+	is.True(errors.Is(err, strconv.ErrSyntax))
+	is.True(maybe.ErrS(errors.New("bad string")).ToU64().IsErr())
+
+	just, err = maybe.JustI(42).ToU64().Unbox()
+	is.Equal(just, uint64(42))
+	is.Nil(err)
+	_, err = maybe.JustI(-42).ToU64().Unbox()
+	is.True(errors.Is(err, strconv.ErrRange))
+	is.True(maybe.ErrI(errors.New("bad int")).ToU64().IsErr())
+
+	_, err = maybe.JustU64(math.MaxUint64).ToI64().Unbox()
+	is.True(errors.Is(err, strconv.ErrRange))
+	_, err = maybe.JustU64(math.MaxUint64).ToI().Unbox()
+	is.True(errors.Is(err, strconv.ErrRange))
+
+	i, err := maybe.JustU64(42).ToI().Unbox()
+	is.Equal(i, 42)
+	is.Nil(err)
+
+	b, err := maybe.JustU64(math.MaxUint64).ToBigI().Unbox()
+	is.Equal(b.String(), "18446744073709551615")
+	is.Nil(err)
+
+	bad := maybe.ErrU64(errors.New("bad uint64"))
+	is.True(bad.ToI().IsErr())
+	is.True(bad.ToI64().IsErr())
+	is.True(bad.ToBigI().IsErr())
+}
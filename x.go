@@ -1,14 +1,16 @@
 package maybe
 
-import "fmt"
+import (
+	"context"
+	"errors"
+	"fmt"
+)
 
 // X implements the Maybe monad for an empty interface.  An X is considered
 // 'valid' or 'invalid' depending on whether it contains a non-nil interface
-// or an error value.
-type X struct {
-	just interface{}
-	err  error
-}
+// or an error value.  A nil interface is an error rather than Nothing; use
+// NothingX for a missing value.
+type X Maybe[interface{}]
 
 // NewX constructs an X from a given empty interface or error. If e is not nil, returns
 // ErrX(e), otherwise returns JustX(s)
@@ -29,6 +31,12 @@ func ErrX(e error) X {
 	return X{err: e}
 }
 
+// NothingX constructs an invalid X that holds nothing, for a missing rather
+// than a failed value.  See ErrNothing.
+func NothingX() X {
+	return X{err: ErrNothing}
+}
+
 // IsErr returns true for an invalid X.
 func (m X) IsErr() bool {
 	return m.just == nil || m.err != nil
@@ -40,16 +48,123 @@ func (m X) Bind(f func(x interface{}) X) X {
 		return m
 	}
 
-	return f(m.just)
+	return try(f, m.just, ErrX)
+}
+
+// BindCtx is like Bind, but the function also takes a context.  If the
+// context is done, BindCtx returns an invalid X with the context's error
+// instead of calling the function.
+func (m X) BindCtx(ctx context.Context, f func(ctx context.Context, x interface{}) X) X {
+	if m.IsErr() {
+		return m
+	}
+	if err := ctx.Err(); err != nil {
+		return ErrX(err)
+	}
+
+	return tryCtx(ctx, f, m.just, ErrX)
 }
 
 // Split applies a function that takes an interface and returns an AoX.
 func (m X) Split(f func(x interface{}) AoX) AoX {
 	if m.IsErr() {
-		return ErrAoX(m.err)
+		return ErrAoX(nilErr(m.err, "Split", "X"))
+	}
+
+	return try(f, m.just, ErrAoX)
+}
+
+// SplitCtx is like Split, but the function also takes a context.  If the
+// context is done, SplitCtx returns an invalid AoX with the context's error
+// instead of calling the function.
+func (m X) SplitCtx(ctx context.Context, f func(ctx context.Context, x interface{}) AoX) AoX {
+	if m.IsErr() {
+		return ErrAoX(nilErr(m.err, "SplitCtx", "X"))
+	}
+	if err := ctx.Err(); err != nil {
+		return ErrAoX(err)
+	}
+
+	return tryCtx(ctx, f, m.just, ErrAoX)
+}
+
+// IsNothing returns true for an X that holds nothing, i.e. one whose error is
+// ErrNothing itself.  Such an X is also invalid, so IsErr returns true.
+func (m X) IsNothing() bool {
+	return Maybe[interface{}](m).IsNothing()
+}
+
+// JustOr returns a valid X holding a default value if the X holds nothing, or
+// otherwise returns the X unchanged.  Unlike OrElse, it leaves other errors in
+// place.  As an X can't hold nil, a nil default gives an invalid X with an
+// error wrapping ErrNilValue.
+func (m X) JustOr(x interface{}) X {
+	if m.IsNothing() {
+		return orNilErr(x, "JustOr")
+	}
+	return m
+}
+
+// OrElse returns the X if it is valid, or otherwise a valid X holding a default
+// value.  As for JustOr, a nil default gives an invalid X with an error
+// wrapping ErrNilValue.
+func (m X) OrElse(x interface{}) X {
+	if m.IsErr() {
+		return orNilErr(x, "OrElse")
 	}
+	return m
+}
 
-	return f(m.just)
+// Recover returns the X if it is valid, or otherwise a valid X holding the
+// value returned by a function that takes the error.  If the function returns
+// nil, the result is an invalid X with an error wrapping ErrNilValue.
+func (m X) Recover(f func(err error) interface{}) X {
+	if !m.IsErr() {
+		return m
+	}
+	_, err := m.Unbox()
+	return try(func(err error) X { return orNilErr(f(err), "Recover") }, err, ErrX)
+}
+
+// MapErr returns the X if it is valid, or otherwise an invalid X with the
+// error returned by a function that takes the original error, e.g. to add
+// context with fmt.Errorf and %w.  If the function returns nil, the original
+// error is kept.
+func (m X) MapErr(f func(err error) error) X {
+	if !m.IsErr() {
+		return m
+	}
+	_, err := m.Unbox()
+	if e := try(f, err, func(e error) error { return e }); e != nil {
+		return ErrX(e)
+	}
+	return ErrX(err)
+}
+
+// Catch is like Recover, but only for errors that match a target according
+// to errors.Is, and the function returns an X, so it may fail again.  Valid
+// values and other errors are returned unchanged.
+func (m X) Catch(target error, f func(err error) X) X {
+	if !m.IsErr() {
+		return m
+	}
+	_, err := m.Unbox()
+	if !errors.Is(err, target) {
+		return m
+	}
+	return try(f, err, ErrX)
+}
+
+// MarshalJSON implements json.Marshaler using the wire format described in
+// the package documentation.
+func (m X) MarshalJSON() ([]byte, error) {
+	return marshalJSON(m.Unbox())
+}
+
+// UnmarshalJSON implements json.Unmarshaler using the wire format described
+// in the package documentation.
+func (m *X) UnmarshalJSON(b []byte) error {
+	return unmarshalJSON(b, (*Maybe[interface{}])(m), "X")
 }
 
 // String returns a string representation, mostly useful for debugging.
@@ -60,7 +175,20 @@ func (m X) String() string {
 	return fmt.Sprintf("Just %v", m.just)
 }
 
-// Unbox returns the underlying empty interface value or error.
+// Unbox returns the underlying empty interface value or error.  For an X
+// holding nil, including the zero value, the error wraps ErrNilValue.
 func (m X) Unbox() (interface{}, error) {
+	if m.just == nil && m.err == nil {
+		return nil, &OpError{Op: "Unbox", Type: "X", Err: ErrNilValue}
+	}
 	return m.just, m.err
 }
+
+// orNilErr boxes a default value for JustOr, OrElse and Recover, which can't
+// make a valid X from nil.
+func orNilErr(x interface{}, op string) X {
+	if x == nil {
+		return ErrX(&OpError{Op: op, Type: "X", Err: ErrNilValue})
+	}
+	return JustX(x)
+}
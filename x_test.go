@@ -86,3 +86,53 @@ func TestXSplit(t *testing.T) {
 	got = bad.Split(f)
 	is.True(got.IsErr())
 }
+
+func TestXRecovery(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	// An X holding nil is invalid, so it can be recovered
+	x, err := maybe.JustX(nil).OrElse("default").Unbox()
+	is.Equal(x, "default")
+	is.Nil(err)
+
+	x, err = maybe.JustX(nil).Catch(maybe.ErrNilValue, func(err error) maybe.X {
+		return maybe.JustX(0)
+	}).Unbox()
+	is.Equal(x, 0)
+	is.Nil(err)
+
+	x, err = maybe.ErrX(errors.New("bad")).Recover(func(err error) interface{} { return err.Error() }).Unbox()
+	is.Equal(x, "bad")
+	is.Nil(err)
+
+	is.Equal(maybe.JustX(1).OrElse(2), maybe.JustX(1))
+
+	// A nil default can't make a valid X
+	bad := maybe.ErrX(errors.New("bad"))
+	_, err = bad.OrElse(nil).Unbox()
+	is.True(errors.Is(err, maybe.ErrNilValue))
+	is.Equal(err.Error(), "X.OrElse: nil value")
+	_, err = bad.Recover(func(err error) interface{} { return nil }).Unbox()
+	is.Equal(err.Error(), "X.Recover: nil value")
+	_, err = maybe.NothingX().JustOr(nil).Unbox()
+	is.Equal(err.Error(), "X.JustOr: nil value")
+}
+
+func TestXNothing(t *testing.T) {
+	is := testy.New(t)
+	defer func() { t.Logf(is.Done()) }()
+
+	// A nil interface is an error, not Nothing
+	nilX := maybe.JustX(nil)
+	is.True(nilX.IsErr())
+	is.False(nilX.IsNothing())
+	_, err := nilX.Unbox()
+	is.True(errors.Is(err, maybe.ErrNilValue))
+
+	n := maybe.NothingX()
+	is.True(n.IsErr())
+	is.True(n.IsNothing())
+	is.Equal(n.JustOr("default"), maybe.JustX("default"))
+	is.True(maybe.JustMoX(map[string]interface{}{}).Get("a").IsNothing())
+}